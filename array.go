@@ -0,0 +1,118 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Array holds one value of type V for each enum associated with type T, with
+// constant time lookups by enum that do not involve hashing (for example,
+// for per-status counters or dispatch tables in hot paths). The IDs of the
+// enums of type T must be contiguous, which is the case unless IDs are set
+// explicitly with NewWithID, Wrap or the HashID option.
+//
+// The enums covered by an Array are the ones returned by EnumsByType when it
+// is created, so it is usually created after all enums are registered.
+type Array[T constraints.Integer, V any] struct {
+	min    T
+	values []V
+}
+
+// NewArray returns a new Array with the given values, which must be given in
+// the order of the IDs of the enums associated with type T (the order of
+// EnumsByType). This panics if the number of values is not the same as the
+// number of enums or if the IDs are not contiguous.
+func NewArray[T constraints.Integer, V any](values ...V) *Array[T, V] {
+	enums := contiguousEnums[T]()
+	if len(values) != len(enums) {
+		panic(fmt.Sprintf("expected %d values for type %s, got %d", len(enums), getTypeName[T](), len(values)))
+	}
+
+	a := &Array[T, V]{values: append([]V(nil), values...)}
+	if len(enums) > 0 {
+		a.min = enums[0].ID()
+	}
+
+	return a
+}
+
+// NewArrayFromMap returns a new Array with the values in the given map. This
+// returns a non-nil error if the map does not have a value for each enum
+// associated with type T. Like NewArray, it panics if the IDs are not
+// contiguous.
+func NewArrayFromMap[T constraints.Integer, V any](m map[Enum[T]]V) (*Array[T, V], error) {
+	enums := contiguousEnums[T]()
+
+	var missing []string
+
+	values := make([]V, len(enums))
+	for i, e := range enums {
+		v, ok := m[e]
+		if !ok {
+			missing = append(missing, e.Name())
+		}
+
+		values[i] = v
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing values for %s of type %s", strings.Join(missing, ", "), getTypeName[T]())
+	}
+
+	if len(m) != len(enums) {
+		return nil, fmt.Errorf("unexpected values for retired or unrecognized enums of type %s", getTypeName[T]())
+	}
+
+	a := &Array[T, V]{values: values}
+	if len(enums) > 0 {
+		a.min = enums[0].ID()
+	}
+
+	return a, nil
+}
+
+// contiguousEnums returns all enums associated with type T and panics if
+// their IDs are not contiguous or gaps are allowed (see AllowGaps).
+func contiguousEnums[T constraints.Integer]() []Enum[T] {
+	checkDense[T]()
+
+	enums := EnumsByType[T]()
+	for i := 1; i < len(enums); i++ {
+		if enums[i].ID() != enums[i-1].ID()+1 {
+			panic(fmt.Sprintf("ids of enums for type %s are not contiguous", getTypeName[T]()))
+		}
+	}
+
+	return enums
+}
+
+// Get returns the value for the given enum. This panics if the enum is not
+// covered by the Array.
+func (a *Array[T, V]) Get(e Member[T]) V {
+	return a.values[a.index(e)]
+}
+
+// Set sets the value for the given enum. This panics if the enum is not
+// covered by the Array. It is not safe to call Set concurrently with Get for
+// the same Array.
+func (a *Array[T, V]) Set(e Member[T], v V) {
+	a.values[a.index(e)] = v
+}
+
+// Len returns the number of values in the Array.
+func (a *Array[T, V]) Len() int {
+	return len(a.values)
+}
+
+func (a *Array[T, V]) index(e Member[T]) int {
+	ie := e.wrapper().internalEnum
+	if ie == nil {
+		panic(notInitialized[T]())
+	}
+
+	// Subtracting as uint64 does not overflow for ranges including negative
+	// IDs (like -128 to 127 for int8).
+	return int(uint64(ie.id) - uint64(a.min))
+}
@@ -0,0 +1,81 @@
+package enum
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestArray(t *testing.T) {
+	a := NewArray[Role]("unknown", "admin", "user", "guest")
+
+	if a.Len() != 4 {
+		t.Errorf("expected 4 values, got %d", a.Len())
+	}
+
+	if v := a.Get(Admin); v != "admin" {
+		t.Errorf("expected admin, got %s", v)
+	}
+
+	a.Set(Guest, "visitor")
+	if v := a.Get(Enum[Role](Guest)); v != "visitor" {
+		t.Errorf("expected visitor, got %s", v)
+	}
+
+	expectPanic(t, func() {
+		NewArray[Role]("unknown", "admin")
+	})
+
+	expectPanic(t, func() {
+		a.Get(RoleEnum{})
+	})
+}
+
+func TestArray_Negative(t *testing.T) {
+	type signed int8
+
+	for id := -128; id <= 127; id++ {
+		NewWithID[signed](fmt.Sprintf("Value%d", id), signed(id))
+	}
+
+	a := NewArray[signed](make([]int, 256)...)
+
+	last, err := FromID(signed(127))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	a.Set(last, 1)
+	if v := a.Get(last); v != 1 {
+		t.Errorf("expected 1, got %d", v)
+	}
+}
+
+func TestNewArrayFromMap(t *testing.T) {
+	a, err := NewArrayFromMap(map[Enum[Permission]]int{
+		Enum[Permission](UnknownPermission): 0,
+		Enum[Permission](Read):              1,
+		Enum[Permission](Write):             2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v := a.Get(Write); v != 2 {
+		t.Errorf("expected 2, got %d", v)
+	}
+
+	if _, err := NewArrayFromMap(map[Enum[Permission]]int{Enum[Permission](Read): 1}); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestArray_NotContiguous(t *testing.T) {
+	type sparse int
+
+	NewWithID[sparse]("A", 1)
+	NewWithID[sparse]("B", 3)
+
+	expectPanic(t, func() {
+		NewArray[sparse](1, 2)
+	})
+}
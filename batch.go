@@ -0,0 +1,127 @@
+package enum
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// IndexError is the error returned by the batch functions (ParseAll, FromIDs
+// and ParseScopes) when an element of the input can not be decoded.
+type IndexError struct {
+	// Index is the position of the element in the input.
+	Index int
+
+	Err error
+}
+
+// Error implements the error interface.
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %s", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// ParseAll is like calling EnumByTypeAndName for each of the given names, but
+// the set of type T is looked up and locked once for the whole batch (and
+// repeated consecutive names are only looked up once), so it is suitable for
+// converting large numbers of rows. If a name can not be decoded, ParseAll
+// stops and returns an *IndexError holding its position.
+func ParseAll[T constraints.Integer](names []string) ([]Enum[T], error) {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	s.MarkUsed()
+
+	enums := make([]Enum[T], len(names))
+	for i := s.getAll(names, enums); i < len(names); i += s.getAll(names[i:], enums[i:]) {
+		// Names that are not registered may still be decoded (for example,
+		// into an unknown or unrecognized enum).
+		e, err := parseInternalEnum[T](names[i])
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+
+		enums[i] = Enum[T]{internalEnumWrapper[T]{e}}
+		i++
+	}
+
+	return enums, nil
+}
+
+// FromIDs is like calling FromID for each of the given IDs (see ParseAll).
+// If an ID can not be found, FromIDs returns an *IndexError holding its
+// position.
+func FromIDs[T constraints.Integer](ids []T) ([]Enum[T], error) {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	s.MarkUsed()
+
+	enums := make([]Enum[T], len(ids))
+	for i := s.getAllByID(ids, enums); i < len(ids); i += s.getAllByID(ids[i:], enums[i:]) {
+		// IDs of enums with a lifecycle policy are decoded one by one.
+		e, err := getInternalEnumForID(ids[i])
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+
+		enums[i] = Enum[T]{internalEnumWrapper[T]{s.Resolve(e)}}
+		i++
+	}
+
+	return enums, nil
+}
+
+// getAll stores the resolved enums registered with the given names in dst
+// and returns the index of the first name that is not registered, or
+// len(names).
+func (s *internalSet[T]) getAll(names []string, dst []Enum[T]) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for i, name := range names {
+		if i > 0 && name == names[i-1] {
+			dst[i] = dst[i-1]
+			continue
+		}
+
+		e, ok := s.nameEnumMap[s.key(name)]
+		if !ok || !s.lifecycleDefaultLocked(e) {
+			return i
+		}
+
+		dst[i] = Enum[T]{internalEnumWrapper[T]{s.resolveLocked(e)}}
+	}
+
+	return len(names)
+}
+
+// getAllByID is like getAll but looks up enums by ID.
+func (s *internalSet[T]) getAllByID(ids []T, dst []Enum[T]) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for i, id := range ids {
+		if i > 0 && id == ids[i-1] {
+			dst[i] = dst[i-1]
+			continue
+		}
+
+		e, ok := s.idEnumMap[id]
+		if !ok || !s.lifecycleDefaultLocked(e) {
+			return i
+		}
+
+		dst[i] = Enum[T]{internalEnumWrapper[T]{s.resolveLocked(e)}}
+	}
+
+	return len(ids)
+}
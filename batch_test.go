@@ -0,0 +1,95 @@
+package enum
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseAll(t *testing.T) {
+	type status int
+
+	active := New[status]("Active")
+	inactive := New[status]("Inactive")
+	legacy := New[status]("Legacy")
+	Retire(legacy, inactive)
+
+	enums, err := ParseAll[status]([]string{"Active", "Active", "Legacy", "Inactive", "Active"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []Enum[status]{active, active, inactive, inactive, active}
+	if !reflect.DeepEqual(enums, expected) {
+		t.Errorf("expected %v, got %v", expected, enums)
+	}
+
+	if enums, err := ParseAll[status](nil); err != nil || len(enums) != 0 {
+		t.Errorf("expected no enums, got %v (%v)", enums, err)
+	}
+
+	_, err = ParseAll[status]([]string{"Active", "Inactive", "Deleted", "Other"})
+
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 2 {
+		t.Fatalf("expected an *IndexError for index 2, got %v", err)
+	}
+
+	if _, expected := EnumByTypeAndName[status]("Deleted"); err.Error() != "index 2: "+expected.Error() {
+		t.Errorf("expected the error of EnumByTypeAndName, got %q", err)
+	}
+
+	Open[status]()
+
+	enums, err = ParseAll[status]([]string{"Active", "Deleted", "Inactive"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if enums[0] != active || enums[1].Name() != "Deleted" || !enums[1].Unrecognized() || enums[2] != inactive {
+		t.Errorf("unexpected enums %v", enums)
+	}
+}
+
+func TestFromIDs(t *testing.T) {
+	type priority uint8
+
+	low := New[priority]("Low")
+	high := New[priority]("High")
+	old := New[priority]("Old")
+	Retire(old, high)
+
+	enums, err := FromIDs([]priority{0, 2, 2, 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []Enum[priority]{low, high, high, high}
+	if !reflect.DeepEqual(enums, expected) {
+		t.Errorf("expected %v, got %v", expected, enums)
+	}
+
+	_, err = FromIDs([]priority{1, 7})
+
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Fatalf("expected an *IndexError for index 1, got %v", err)
+	}
+
+	if _, err := FromIDs([]int32{1}); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func BenchmarkParseAll(b *testing.B) {
+	names := make([]string, 1000)
+	for i := range names {
+		names[i] = []string{"Admin", "User", "Guest"}[i%3]
+	}
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseAll[Role](names)
+	}
+}
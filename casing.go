@@ -0,0 +1,133 @@
+package enum
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// The functions below convert names between casing conventions. They can be
+// used as normalizers (see SetNormalizer), in which case names are marshaled
+// in the given convention:
+//
+//	enum.SetNormalizer[Role](enum.ToSnakeCase)
+//
+// Names are split into words at underscores, hyphens, spaces and dots and at
+// case changes. Sequences of upper case letters are kept together as
+// acronyms, so "HTTPServer" and "userID" are split into "HTTP", "Server" and
+// "user", "ID".
+//
+// With ToSnakeCase, ToScreamingSnakeCase and ToKebabCase, names in any of the
+// conventions are accepted when parsing. ToCamelCase and ToLowerCamelCase can
+// not tell acronyms from other words in names that are all in lower or upper
+// case ("user_id" is converted to "UserId", not "UserID"), so with them names
+// with acronyms are only accepted in camel case.
+
+// ToCamelCase converts the given name to CamelCase ("UserID"). Acronyms are
+// kept in upper case unless the whole name is in upper case.
+func ToCamelCase(name string) string {
+	return joinCamel(splitWords(name), true)
+}
+
+// ToLowerCamelCase converts the given name to lowerCamelCase ("userID").
+func ToLowerCamelCase(name string) string {
+	return joinCamel(splitWords(name), false)
+}
+
+// ToSnakeCase converts the given name to snake_case ("user_id").
+func ToSnakeCase(name string) string {
+	return cases.Lower(language.Und).String(strings.Join(splitWords(name), "_"))
+}
+
+// ToScreamingSnakeCase converts the given name to SCREAMING_SNAKE_CASE
+// ("USER_ID").
+func ToScreamingSnakeCase(name string) string {
+	return cases.Upper(language.Und).String(strings.Join(splitWords(name), "_"))
+}
+
+// ToKebabCase converts the given name to kebab-case ("user-id").
+func ToKebabCase(name string) string {
+	return cases.Lower(language.Und).String(strings.Join(splitWords(name), "-"))
+}
+
+func joinCamel(words []string, upperFirst bool) string {
+	allUpper := true
+	for _, w := range words {
+		if !isUpper(w) {
+			allUpper = false
+			break
+		}
+	}
+
+	// Casers are not safe for concurrent use so they are not shared.
+	title := cases.Title(language.Und)
+	lower := cases.Lower(language.Und)
+
+	var b strings.Builder
+	for i, w := range words {
+		switch {
+		case i == 0 && !upperFirst:
+			w = lower.String(w)
+		case isUpper(w) && !allUpper:
+			// Acronym.
+		default:
+			w = title.String(w)
+		}
+
+		b.WriteString(w)
+	}
+
+	return b.String()
+}
+
+// isUpper returns true if s has letters and all of them are in upper case.
+func isUpper(s string) bool {
+	hasLetter := false
+	for _, r := range s {
+		if unicode.IsLower(r) {
+			return false
+		}
+
+		hasLetter = hasLetter || unicode.IsLetter(r)
+	}
+
+	return hasLetter
+}
+
+// splitWords splits the given name into words.
+func splitWords(name string) []string {
+	var words []string
+
+	runes := []rune(name)
+	start := 0
+
+	flush := func(end int) {
+		if end > start {
+			words = append(words, string(runes[start:end]))
+		}
+	}
+
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == '.' || unicode.IsSpace(r):
+			flush(i)
+			start = i + 1
+		case i > start && unicode.IsUpper(r):
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			// Boundaries are "aB" and the "B" in "ABc" (where "A" ends an
+			// acronym).
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush(i)
+				start = i
+			}
+		}
+	}
+
+	flush(len(runes))
+
+	return words
+}
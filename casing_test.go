@@ -0,0 +1,106 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCasing(t *testing.T) {
+	tests := []struct {
+		name                                       string
+		camel, lowerCamel, snake, screaming, kebab string
+	}{
+		{"UserID", "UserID", "userID", "user_id", "USER_ID", "user-id"},
+		{"HTTPServer", "HTTPServer", "httpServer", "http_server", "HTTP_SERVER", "http-server"},
+		{"http_server", "HttpServer", "httpServer", "http_server", "HTTP_SERVER", "http-server"},
+		{"HTTP_SERVER", "HttpServer", "httpServer", "http_server", "HTTP_SERVER", "http-server"},
+		{"in-progress", "InProgress", "inProgress", "in_progress", "IN_PROGRESS", "in-progress"},
+		{"v2Beta", "V2Beta", "v2Beta", "v2_beta", "V2_BETA", "v2-beta"},
+		{"Admin", "Admin", "admin", "admin", "ADMIN", "admin"},
+		{"\u00c9tatCivil", "\u00c9tatCivil", "\u00e9tatCivil", "\u00e9tat_civil", "\u00c9TAT_CIVIL", "\u00e9tat-civil"},
+	}
+
+	for _, test := range tests {
+		for _, c := range []struct {
+			f        func(string) string
+			expected string
+		}{
+			{ToCamelCase, test.camel},
+			{ToLowerCamelCase, test.lowerCamel},
+			{ToSnakeCase, test.snake},
+			{ToScreamingSnakeCase, test.screaming},
+			{ToKebabCase, test.kebab},
+		} {
+			if got := c.f(test.name); got != c.expected {
+				t.Errorf("expected %s for %s, got %s", c.expected, test.name, got)
+			}
+		}
+	}
+}
+
+func TestCasing_Normalizer(t *testing.T) {
+	type state int
+
+	SetNormalizer[state](ToScreamingSnakeCase)
+
+	inProgress := New[state]("InProgress")
+
+	data, err := json.Marshal(inProgress)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if string(data) != `"IN_PROGRESS"` {
+		t.Errorf("expected \"IN_PROGRESS\", got %s", data)
+	}
+
+	for _, name := range []string{"InProgress", "inProgress", "in_progress", "in-progress", "IN_PROGRESS"} {
+		e, err := EnumByTypeAndName[state](name)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %s", name, err)
+		}
+
+		if e != inProgress {
+			t.Errorf("expected %s for %s, got %s", inProgress, name, e)
+		}
+	}
+}
+
+func TestCasing_NormalizerAcronyms(t *testing.T) {
+	type snakeProtocol int
+
+	SetNormalizer[snakeProtocol](ToSnakeCase)
+
+	userID := New[snakeProtocol]("UserID")
+	httpServer := New[snakeProtocol]("HTTPServer")
+
+	for expected, names := range map[Enum[snakeProtocol]][]string{
+		userID:     {"UserID", "userID", "user_id", "USER_ID", "user-id"},
+		httpServer: {"HTTPServer", "httpServer", "http_server", "HTTP_SERVER", "http-server"},
+	} {
+		for _, name := range names {
+			if e, err := EnumByTypeAndName[snakeProtocol](name); err != nil || e != expected {
+				t.Errorf("expected %s for %s, got %v (%v)", expected, name, e, err)
+			}
+		}
+	}
+
+	type camelProtocol int
+
+	SetNormalizer[camelProtocol](ToCamelCase)
+
+	camelUserID := New[camelProtocol]("UserID")
+
+	for _, name := range []string{"UserID", "userID"} {
+		if e, err := EnumByTypeAndName[camelProtocol](name); err != nil || e != camelUserID {
+			t.Errorf("expected %s for %s, got %v (%v)", camelUserID, name, e, err)
+		}
+	}
+
+	// The acronym can not be recovered from these names.
+	for _, name := range []string{"user_id", "USER_ID", "user-id"} {
+		if _, err := EnumByTypeAndName[camelProtocol](name); err == nil {
+			t.Errorf("expected error for %s, got nil", name)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package enum
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"golang.org/x/exp/constraints"
+)
+
+// NewChar is like NewWithID but uses the given character as the ID. It is
+// intended for types based on byte or rune whose values are encoded as a
+// single character in external formats (for example, transaction types
+// encoded as 'D' or 'C' in bank files):
+//
+//	type TransactionType byte
+//
+//	var (
+//		Debit  = enum.NewChar[TransactionType]("Debit", 'D')
+//		Credit = enum.NewChar[TransactionType]("Credit", 'C')
+//	)
+//
+// This panics if the character does not fit in type T. Use CharEncoded to
+// also use the character when marshaling.
+func NewChar[T constraints.Integer](name string, char rune, opts ...Option) Enum[T] {
+	if name == "" {
+		panic(emptyName[T](callSite(1)))
+	}
+
+	if char < 0 || T(char) < 0 || rune(T(char)) != char {
+		panic(fmt.Sprintf("character %q out of range for type %s", char, getTypeName[T]()))
+	}
+
+	attrs := attributes{registeredAt: callSite(1)}
+	for _, opt := range opts {
+		opt(&attrs)
+	}
+
+	s := getOrCreateSetForType[T]()
+
+	return Enum[T]{internalEnumWrapper[T]{s.AddWithID(name, T(char), attrs)}}
+}
+
+// CharEncoded makes enums associated with type T be marshaled (as text, JSON
+// or SQL values) as the character of their ID instead of their name, so
+// Debit in the NewChar example is marshaled as "D". When unmarshaling, both
+// the character and the name are accepted.
+func CharEncoded[T constraints.Integer]() {
+	getOrCreateSetForType[T]().SetCharEncoded(true)
+}
+
+// Char returns the ID of this Enum as a character (see NewChar).
+func (e internalEnumWrapper[T]) Char() rune {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return rune(e.id)
+}
+
+// encodedName returns the name this Enum is marshaled as.
+func (e *internalEnum[T]) encodedName() string {
+	if e.set != nil && e.set.CharEncoded() && !e.unrecognized {
+		return string(rune(e.id))
+	}
+
+	return e.name
+}
+
+// getChar returns the enum whose ID is the single character in s if the set
+// is char encoded, or nil.
+func (s *internalSet[T]) getChar(name string) *internalEnum[T] {
+	r, size := utf8.DecodeRuneInString(name)
+	if size == 0 || size != len(name) || r == utf8.RuneError || !s.CharEncoded() {
+		return nil
+	}
+
+	if T(r) < 0 || rune(T(r)) != r {
+		return nil
+	}
+
+	e, err := s.GetByID(T(r))
+	if err != nil || e.unrecognized {
+		return nil
+	}
+
+	return e
+}
@@ -0,0 +1,90 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+type transactionType byte
+
+var (
+	debit  = NewChar[transactionType]("Debit", 'D')
+	credit = NewChar[transactionType]("Credit", 'C')
+)
+
+func init() {
+	CharEncoded[transactionType]()
+}
+
+func TestNewChar(t *testing.T) {
+	if debit.ID() != 'D' || debit.Char() != 'D' || debit.Name() != "Debit" {
+		t.Errorf("unexpected enum %s (%d)", debit, debit.ID())
+	}
+
+	expectPanic(t, func() {
+		NewChar[transactionType]("Euro", '\u20ac')
+	})
+
+	expectPanic(t, func() {
+		NewChar[int8]("Invalid", 200)
+	})
+
+	type glyph rune
+
+	euro := NewChar[glyph]("Euro", '\u20ac')
+	if euro.Char() != '\u20ac' {
+		t.Errorf("expected %q, got %q", '\u20ac', euro.Char())
+	}
+}
+
+func TestCharEncoded(t *testing.T) {
+	data, err := json.Marshal([]Enum[transactionType]{debit, credit})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if string(data) != `["D","C"]` {
+		t.Errorf("expected [\"D\",\"C\"], got %s", data)
+	}
+
+	for _, text := range []string{"C", "Credit"} {
+		var e Enum[transactionType]
+		if err := e.UnmarshalText([]byte(text)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if e != credit {
+			t.Errorf("expected %s, got %s", credit, e)
+		}
+	}
+
+	var e Enum[transactionType]
+	if err := e.UnmarshalText([]byte("X")); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if data, _ := Admin.MarshalText(); string(data) != "Admin" {
+		t.Errorf("expected Admin, got %s", data)
+	}
+}
+
+func TestByteCapacity(t *testing.T) {
+	type octet uint8
+
+	for i := 0; i < 256; i++ {
+		New[octet](fmt.Sprintf("Value%d", i))
+	}
+
+	expectPanic(t, func() {
+		New[octet]("Overflow")
+	})
+
+	if got := maxEnums[octet](); got != 256 {
+		t.Errorf("expected 256, got %d", got)
+	}
+
+	if got := maxEnums[int64](); got != 0 {
+		t.Errorf("expected 0, got %d", got)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+)
+
+// generateConsts returns the source of a file declaring a constant for each
+// enum in pkg and an init function verifying them.
+func generateConsts(pkg *declaredPackage) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by enumgen consts -type %s; DO NOT EDIT.\n\n", pkg.typeName)
+	fmt.Fprintf(&buf, "package %s\n\n", pkg.name)
+	fmt.Fprintf(&buf, "import \"fmt\"\n\n")
+
+	fmt.Fprintf(&buf, "// IDs of the enums associated with type %s.\n", pkg.typeName)
+	fmt.Fprintf(&buf, "const (\n")
+	for _, e := range pkg.enums {
+		fmt.Fprintf(&buf, "%sID %s = %d\n", e.varName, pkg.typeName, e.id)
+	}
+	fmt.Fprintf(&buf, ")\n\n")
+
+	fmt.Fprintf(&buf, "func init() {\n")
+	for _, e := range pkg.enums {
+		fmt.Fprintf(&buf, "if %s.ID() != %sID {\n", e.varName, e.varName)
+		fmt.Fprintf(&buf, "panic(fmt.Sprintf(\"enum %%s has ID %%d but %sID is %%d (rerun enumgen)\", %q, %s.ID(), %sID))\n",
+			e.varName, e.name, e.varName, e.varName)
+		fmt.Fprintf(&buf, "}\n")
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	return format.Source(buf.Bytes())
+}
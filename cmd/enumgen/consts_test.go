@@ -0,0 +1,115 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	return dir
+}
+
+func TestGenerateConsts(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a.go": `package roles
+
+import "github.com/bruno-ga/enum"
+
+type Role int
+
+type RoleEnum enum.Enum[Role]
+
+var (
+	Unknown = RoleEnum(enum.New[Role]("Unknown"))
+	Admin   = RoleEnum(enum.New[Role]("Admin", enum.Deprecated()))
+)
+
+var Other = enum.New[int]("Other")
+`,
+		"b.go": `package roles
+
+import e "github.com/bruno-ga/enum"
+
+var User, _, Guest = e.New[Role]("User"), e.New[Role]("Reserved"), e.New[Role]("Guest")
+
+var _ = e.New[Role]("Reserved2")
+`,
+		"a_test.go": `package roles
+
+import "github.com/bruno-ga/enum"
+
+var Test = enum.New[Role]("Test")
+`,
+		"role_consts.go": `// Code generated by enumgen consts -type Role; DO NOT EDIT.
+
+package roles
+
+import "github.com/bruno-ga/enum"
+
+var Stale = enum.New[Role]("Stale")
+`,
+	})
+
+	pkg, err := parseDir(dir, "Role")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	src, err := generateConsts(pkg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, expected := range []string{
+		"package roles\n",
+		"UnknownID Role = 0\n",
+		"AdminID   Role = 1\n",
+		"UserID    Role = 2\n",
+		"GuestID   Role = 4\n",
+		`if Admin.ID() != AdminID {`,
+	} {
+		if !strings.Contains(string(src), expected) {
+			t.Errorf("expected generated source to contain %q, got:\n%s", expected, src)
+		}
+	}
+
+	for _, unexpected := range []string{"Other", "Test", "Stale", "_ID", "_.ID()"} {
+		if strings.Contains(string(src), unexpected) {
+			t.Errorf("expected generated source not to contain %q, got:\n%s", unexpected, src)
+		}
+	}
+}
+
+func TestGenerateConsts_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"no enums", "package roles\n\ntype Role int\n"},
+		{"explicit id", "package roles\n\nimport \"github.com/bruno-ga/enum\"\n\ntype Role int\n\nvar A = enum.NewWithID[Role](\"A\", 5)\n"},
+		{"hashed id", "package roles\n\nimport \"github.com/bruno-ga/enum\"\n\ntype Role int\n\nvar A = enum.New[Role](\"A\", enum.HashID())\n"},
+		{"declared", "package roles\n\nimport \"github.com/bruno-ga/enum\"\n\ntype Role int\n\nvar A = enum.Declare[Role](\"A\")\n"},
+		{"non-literal name", "package roles\n\nimport \"github.com/bruno-ga/enum\"\n\ntype Role int\n\nconst name = \"A\"\n\nvar A = enum.New[Role](name)\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := writeFiles(t, map[string]string{"a.go": test.src})
+
+			if _, err := parseDir(dir, "Role"); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+		})
+	}
+}
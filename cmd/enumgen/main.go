@@ -0,0 +1,163 @@
+// Command enumgen generates code for enums declared with the enum package.
+//
+// Usage:
+//
+//	enumgen consts -type T [-output file] [dir]
+//	enumgen static -type T [-enum E] [-output file] definitions.yaml
+//
+// The consts mode generates a constant of type T for every enum of that type
+// declared at package level in dir (the current directory by default), named
+// after the variable with an ID suffix:
+//
+//	var Admin = enum.New[Role]("Admin")
+//
+// results in:
+//
+//	const AdminID Role = 1
+//
+// IDs are computed from the declaration order, the same way New assigns them.
+// The generated file also contains an init function that panics if the
+// constants do not match the IDs registered at run time. It is usually run
+// through a go:generate directive:
+//
+//	//go:generate enumgen consts -type Role
+//
+// The static mode generates a fully static implementation of the enums
+// declared in a definitions file (in the format accepted by
+// enum.LoadDefinitions) for code that avoids the run-time registry. The
+// generated type E (T followed by Enum by default) has the same methods as
+// enum.Enum[T], implemented with constant IDs and switch statements, and a
+// variable is declared for every enum, named after it in CamelCase:
+//
+//	//go:generate enumgen static -type Role roles.yaml
+//
+// results in:
+//
+//	const AdminID Role = 10
+//
+//	var Admin = RoleEnum{...}
+//
+//	func ParseRoleEnum(name string) (RoleEnum, error)
+//	func RoleEnumFromID(id Role) (RoleEnum, error)
+//	func RoleEnumValues() []RoleEnum
+//
+// Type T must be declared in the package of the generated file, which is the
+// directory of the definitions file by default.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var err error
+
+	switch mode := os.Args[1]; mode {
+	case "consts":
+		err = runConsts(os.Args[2:])
+	case "static":
+		err = runStatic(os.Args[2:])
+	default:
+		fmt.Fprintf(os.Stderr, "enumgen: unknown mode %q\n", mode)
+		usage()
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "enumgen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: enumgen consts -type T [-output file] [dir]")
+	fmt.Fprintln(os.Stderr, "       enumgen static -type T [-enum E] [-output file] definitions.yaml")
+	os.Exit(2)
+}
+
+func runConsts(args []string) error {
+	fs := flag.NewFlagSet("consts", flag.ExitOnError)
+	typeName := fs.String("type", "", "name of the type associated with the enums (required)")
+	output := fs.String("output", "", "output file name (default <type>_consts.go in dir)")
+	_ = fs.Parse(args)
+
+	if *typeName == "" {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if fs.NArg() > 0 {
+		dir = fs.Arg(0)
+	}
+
+	pkg, err := parseDir(dir, *typeName)
+	if err != nil {
+		return err
+	}
+
+	src, err := generateConsts(pkg)
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		*output = defaultOutput(dir, *typeName, "consts")
+	}
+
+	return os.WriteFile(*output, src, 0o644)
+}
+
+func runStatic(args []string) error {
+	fs := flag.NewFlagSet("static", flag.ExitOnError)
+	typeName := fs.String("type", "", "name of the type associated with the enums (required)")
+	enumName := fs.String("enum", "", "name of the generated enum type (default <type>Enum)")
+	output := fs.String("output", "", "output file name (default <type>_static.go in the definitions directory)")
+	_ = fs.Parse(args)
+
+	if *typeName == "" || fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	if *enumName == "" {
+		*enumName = *typeName + "Enum"
+	}
+
+	data, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	enums, err := parseDefinitions(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", fs.Arg(0), err)
+	}
+
+	if *output == "" {
+		*output = defaultOutput(filepath.Dir(fs.Arg(0)), *typeName, "static")
+	}
+
+	name, err := packageName(filepath.Dir(*output))
+	if err != nil {
+		return err
+	}
+
+	src, err := generateStatic(&staticPackage{
+		Package:  name,
+		TypeName: *typeName,
+		EnumName: *enumName,
+		Enums:    enums,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(*output, src, 0o644)
+}
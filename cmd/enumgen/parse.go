@@ -0,0 +1,253 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const enumImportPath = "github.com/bruno-ga/enum"
+
+// declaredPackage contains the enums declared at package level for a type.
+type declaredPackage struct {
+	name     string
+	typeName string
+	enums    []declaredEnum
+
+	// count is the number of enums declared so far, including the ones of
+	// blank variables.
+	count int64
+}
+
+// declaredEnum is an enum declared with a package level variable.
+type declaredEnum struct {
+	varName string
+	name    string
+	id      int64
+}
+
+// parseDir collects all enums associated with typeName declared in the
+// non-test Go files in dir. Files are processed in name order, which is the
+// order the go tool passes them to the compiler, so IDs match the ones
+// assigned by New.
+func parseDir(dir, typeName string) (*declaredPackage, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		files = append(files, filepath.Join(dir, name))
+	}
+
+	sort.Strings(files)
+
+	pkg := &declaredPackage{typeName: typeName}
+	fset := token.NewFileSet()
+
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		if isEnumgenOutput(f) {
+			continue
+		}
+
+		if pkg.name == "" {
+			pkg.name = f.Name.Name
+		} else if pkg.name != f.Name.Name {
+			return nil, fmt.Errorf("multiple packages in %s: %s and %s", dir, pkg.name, f.Name.Name)
+		}
+
+		if err := pkg.collect(fset, f); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(pkg.enums) == 0 {
+		return nil, fmt.Errorf("no enums associated with type %s declared in %s", typeName, dir)
+	}
+
+	return pkg, nil
+}
+
+// isEnumgenOutput returns true if f was generated by enumgen, so previous
+// output is ignored. Enums declared in files generated by other tools are
+// still collected as they are registered like any other.
+func isEnumgenOutput(f *ast.File) bool {
+	for _, group := range f.Comments {
+		if group.Pos() > f.Package {
+			break
+		}
+
+		for _, c := range group.List {
+			if strings.HasPrefix(c.Text, "// Code generated by enumgen ") && strings.HasSuffix(c.Text, " DO NOT EDIT.") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (p *declaredPackage) collect(fset *token.FileSet, f *ast.File) error {
+	enumName := enumImportName(f)
+	if enumName == "" {
+		return nil
+	}
+
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if len(vs.Values) != len(vs.Names) {
+				continue
+			}
+
+			for i, value := range vs.Values {
+				call, fun := p.newCall(enumName, value)
+				if call == nil {
+					continue
+				}
+
+				// IDs are derived from the declaration order, which only
+				// matches the IDs assigned by New.
+				if fun != "New" {
+					return fmt.Errorf("%s: enums declared with %s.%s are not supported", fset.Position(call.Pos()), enumName, fun)
+				}
+
+				if len(call.Args) == 0 {
+					return fmt.Errorf("%s: missing enum name", fset.Position(call.Pos()))
+				}
+
+				lit, ok := call.Args[0].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					return fmt.Errorf("%s: enum name must be a string literal", fset.Position(call.Args[0].Pos()))
+				}
+
+				name, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					return err
+				}
+
+				for _, arg := range call.Args[1:] {
+					if isEnumCall(enumName, arg, "HashID") {
+						return fmt.Errorf("%s: enums with hashed IDs are not supported", fset.Position(arg.Pos()))
+					}
+				}
+
+				id := p.count
+				p.count++
+
+				// Enums of blank variables get an ID but have no constant.
+				if vs.Names[i].Name == "_" {
+					continue
+				}
+
+				p.enums = append(p.enums, declaredEnum{
+					varName: vs.Names[i].Name,
+					name:    name,
+					id:      id,
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+// newCall returns the call registering an enum of the type in expr, which may
+// be wrapped in a conversion to a derived type (for example,
+// RoleEnum(enum.New[Role]("A"))), and the name of the called function (New,
+// NewWithID or Declare).
+func (p *declaredPackage) newCall(enumName string, expr ast.Expr) (*ast.CallExpr, string) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return nil, ""
+	}
+
+	index, ok := call.Fun.(*ast.IndexExpr)
+	if !ok {
+		if len(call.Args) == 1 {
+			return p.newCall(enumName, call.Args[0])
+		}
+
+		return nil, ""
+	}
+
+	sel, ok := index.X.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "New" && sel.Sel.Name != "NewWithID" && sel.Sel.Name != "Declare") {
+		return nil, ""
+	}
+
+	if x, ok := sel.X.(*ast.Ident); !ok || x.Name != enumName {
+		return nil, ""
+	}
+
+	if t, ok := index.Index.(*ast.Ident); !ok || t.Name != p.typeName {
+		return nil, ""
+	}
+
+	return call, sel.Sel.Name
+}
+
+// isEnumCall returns true if expr is a call to the given function of the enum
+// package.
+func isEnumCall(enumName string, expr ast.Expr, fun string) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != fun {
+		return false
+	}
+
+	x, ok := sel.X.(*ast.Ident)
+
+	return ok && x.Name == enumName
+}
+
+// enumImportName returns the name the enum package is imported as in f, or an
+// empty string if it is not imported.
+func enumImportName(f *ast.File) string {
+	for _, imp := range f.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || path != enumImportPath {
+			continue
+		}
+
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+
+		return "enum"
+	}
+
+	return ""
+}
+
+// defaultOutput returns the default output file for the given mode.
+func defaultOutput(dir, typeName, mode string) string {
+	return filepath.Join(dir, strings.ToLower(typeName)+"_"+mode+".go")
+}
@@ -0,0 +1,483 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+
+	"github.com/bruno-ga/enum"
+	"gopkg.in/yaml.v3"
+)
+
+// definition is a single enum declared in a definitions file, in the format
+// accepted by enum.LoadDefinitions. Definitions files are decoded with
+// unknown fields rejected, so fields added to the format without being added
+// here are reported instead of being silently ignored.
+type definition struct {
+	Name        string            `yaml:"name"`
+	ID          *int64            `yaml:"id"`
+	DisplayName string            `yaml:"display_name"`
+	Description string            `yaml:"description"`
+	Deprecated  bool              `yaml:"deprecated"`
+	Groups      []string          `yaml:"groups"`
+	Tags        []string          `yaml:"tags"`
+	Metadata    map[string]string `yaml:"metadata"`
+	ValidFrom   time.Time         `yaml:"valid_from"`
+	ValidUntil  time.Time         `yaml:"valid_until"`
+}
+
+// staticEnum is an enum of the generated static implementation.
+type staticEnum struct {
+	Index      int // Index in the generated table plus one.
+	VarName    string
+	Name       string
+	ID         int64
+	Deprecated bool
+	Groups     []string
+	Tags       []string
+	Metadata   [][2]string // Sorted by key.
+}
+
+// staticPackage contains everything needed to generate a static
+// implementation.
+type staticPackage struct {
+	Package  string
+	TypeName string
+	EnumName string
+	Enums    []staticEnum
+}
+
+// Helper returns the prefix of unexported identifiers of the generated file.
+func (p *staticPackage) Helper() string {
+	return strings.ToLower(p.EnumName[:1]) + p.EnumName[1:]
+}
+
+// parseDefinitions returns the enums declared in the given definitions
+// document, with IDs assigned the same way enum.LoadDefinitions does.
+func parseDefinitions(data []byte) ([]staticEnum, error) {
+	var defs []definition
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&defs); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("invalid enum definitions: %w", err)
+	}
+
+	enums := make([]staticEnum, 0, len(defs))
+	names := make(map[string]bool, len(defs))
+	vars := make(map[string]bool, len(defs))
+	ids := make(map[int64]bool, len(defs))
+	nextID := int64(0)
+
+	for i, def := range defs {
+		if def.Name == "" {
+			return nil, fmt.Errorf("definition %d has no name", i)
+		}
+
+		if names[def.Name] {
+			return nil, fmt.Errorf("duplicate name %s", def.Name)
+		}
+
+		names[def.Name] = true
+
+		// The generated implementation has no clock to check validity
+		// periods against.
+		if !def.ValidFrom.IsZero() || !def.ValidUntil.IsZero() {
+			return nil, fmt.Errorf("definition %s has a validity period, which is not supported by static implementations", def.Name)
+		}
+
+		varName := enum.ToCamelCase(def.Name)
+		if !token.IsIdentifier(varName) || !token.IsExported(varName) {
+			return nil, fmt.Errorf("name %s can not be converted to an exported identifier", def.Name)
+		}
+
+		if vars[varName] {
+			return nil, fmt.Errorf("names of multiple enums are converted to identifier %s", varName)
+		}
+
+		vars[varName] = true
+
+		var id int64
+		if def.ID != nil {
+			id = *def.ID
+		} else {
+			for ids[nextID] {
+				nextID++
+			}
+
+			id = nextID
+			nextID++
+		}
+
+		if ids[id] {
+			return nil, fmt.Errorf("duplicate id %d", id)
+		}
+
+		ids[id] = true
+
+		metadata := make(map[string]string, len(def.Metadata)+2)
+		for key, value := range def.Metadata {
+			metadata[key] = value
+		}
+
+		if def.DisplayName != "" {
+			metadata[enum.MetadataDisplayName] = def.DisplayName
+		}
+
+		if def.Description != "" {
+			metadata[enum.MetadataDescription] = def.Description
+		}
+
+		e := staticEnum{
+			Index:      len(enums) + 1,
+			VarName:    varName,
+			Name:       def.Name,
+			ID:         id,
+			Deprecated: def.Deprecated,
+			Groups:     def.Groups,
+			Tags:       def.Tags,
+		}
+
+		for key, value := range metadata {
+			e.Metadata = append(e.Metadata, [2]string{key, value})
+		}
+
+		sort.Slice(e.Metadata, func(i, j int) bool {
+			return e.Metadata[i][0] < e.Metadata[j][0]
+		})
+
+		enums = append(enums, e)
+	}
+
+	if len(enums) == 0 {
+		return nil, fmt.Errorf("no enums defined")
+	}
+
+	return enums, nil
+}
+
+// packageName returns the name of the package in dir, or the name of dir if
+// it has no Go files.
+func packageName(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	fset := token.NewFileSet()
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.PackageClauseOnly)
+		if err != nil {
+			return "", err
+		}
+
+		return f.Name.Name, nil
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return r
+		}
+
+		return -1
+	}, filepath.Base(abs))
+
+	if !token.IsIdentifier(name) {
+		return "", fmt.Errorf("can not determine package name for %s", dir)
+	}
+
+	return name, nil
+}
+
+// generateStatic returns the source of a file implementing the enums in pkg
+// without the run-time registry.
+func generateStatic(pkg *staticPackage) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := staticTemplate.Execute(&buf, pkg); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+var staticTemplate = template.Must(template.New("static").Parse(`// Code generated by enumgen static -type {{.TypeName}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// IDs of the enums associated with type {{.TypeName}}.
+const (
+{{- range .Enums}}
+	{{.VarName}}ID {{$.TypeName}} = {{.ID}}
+{{- end}}
+)
+
+// Enums associated with type {{.TypeName}}.
+var (
+{{- range .Enums}}
+	{{.VarName}} = {{$.EnumName}}{ {{- .Index}}}
+{{- end}}
+)
+
+// {{.EnumName}} is an enum associated with type {{.TypeName}}. It has the same
+// methods as enum.Enum[{{.TypeName}}] but does not use the run-time registry.
+// Default {{.EnumName}} instances are invalid.
+type {{.EnumName}} struct {
+	// index is the index of the enum in {{.Helper}}Values plus one, or zero if
+	// the enum is not initialized.
+	index int
+}
+
+type {{.Helper}}Value struct {
+	name       string
+	id         {{.TypeName}}
+	deprecated bool
+	groups     []string
+	tags       []string
+	metadata   map[string]string
+}
+
+var {{.Helper}}Values = [...]{{.Helper}}Value{
+{{- range .Enums}}
+	{
+		name: {{printf "%q" .Name}},
+		id:   {{.ID}},
+		{{- if .Deprecated}}
+		deprecated: true,
+		{{- end}}
+		{{- if .Groups}}
+		groups: []string{ {{- range $i, $g := .Groups}}{{if $i}}, {{end}}{{printf "%q" $g}}{{end}}},
+		{{- end}}
+		{{- if .Tags}}
+		tags: []string{ {{- range $i, $t := .Tags}}{{if $i}}, {{end}}{{printf "%q" $t}}{{end}}},
+		{{- end}}
+		{{- if .Metadata}}
+		metadata: map[string]string{
+		{{- range .Metadata}}
+			{{printf "%q" (index . 0)}}: {{printf "%q" (index . 1)}},
+		{{- end}}
+		},
+		{{- end}}
+	},
+{{- end}}
+}
+
+// {{.EnumName}}Values returns all enums associated with type {{.TypeName}} in
+// declaration order.
+func {{.EnumName}}Values() []{{.EnumName}} {
+	return []{{.EnumName}}{ {{- range $i, $e := .Enums}}{{if $i}}, {{end}}{{$e.VarName}}{{end}}}
+}
+
+// Parse{{.EnumName}} returns the enum with the given name.
+func Parse{{.EnumName}}(name string) ({{.EnumName}}, error) {
+	switch name {
+{{- range .Enums}}
+	case {{printf "%q" .Name}}:
+		return {{.VarName}}, nil
+{{- end}}
+	}
+
+	return {{.EnumName}}{}, fmt.Errorf("name %s could not be found in enum set for type {{.TypeName}}", name)
+}
+
+// {{.EnumName}}FromID returns the enum with the given ID.
+func {{.EnumName}}FromID(id {{.TypeName}}) ({{.EnumName}}, error) {
+	switch id {
+{{- range .Enums}}
+	case {{.VarName}}ID:
+		return {{.VarName}}, nil
+{{- end}}
+	}
+
+	return {{.EnumName}}{}, fmt.Errorf("id %d could not be found in enum set for type {{.TypeName}}", id)
+}
+
+func (e {{.EnumName}}) value() *{{.Helper}}Value {
+	if e.index == 0 {
+		panic("enum not initialized")
+	}
+
+	return &{{.Helper}}Values[e.index-1]
+}
+
+// Name returns the name of this enum.
+func (e {{.EnumName}}) Name() string {
+	return e.value().name
+}
+
+// ID returns the ID of this enum.
+func (e {{.EnumName}}) ID() {{.TypeName}} {
+	return e.value().id
+}
+
+// Deprecated returns true if this enum is deprecated.
+func (e {{.EnumName}}) Deprecated() bool {
+	return e.value().deprecated
+}
+
+// Groups returns a copy of the groups of this enum.
+func (e {{.EnumName}}) Groups() []string {
+	return append([]string(nil), e.value().groups...)
+}
+
+// InGroup returns true if this enum is in the given group.
+func (e {{.EnumName}}) InGroup(group string) bool {
+	for _, g := range e.value().groups {
+		if g == group {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Tags returns a copy of the tags of this enum.
+func (e {{.EnumName}}) Tags() []string {
+	return append([]string(nil), e.value().tags...)
+}
+
+// HasTag returns true if this enum has the given tag.
+func (e {{.EnumName}}) HasTag(tag string) bool {
+	for _, t := range e.value().tags {
+		if t == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Metadata returns a copy of all metadata associated with this enum.
+func (e {{.EnumName}}) Metadata() map[string]string {
+	metadata := make(map[string]string, len(e.value().metadata))
+	for key, value := range e.value().metadata {
+		metadata[key] = value
+	}
+
+	return metadata
+}
+
+// MetadataValue returns the metadata value associated with the given key and
+// true, or false if there is none.
+func (e {{.EnumName}}) MetadataValue(key string) (string, bool) {
+	value, ok := e.value().metadata[key]
+
+	return value, ok
+}
+
+// Valid returns true if this enum is valid or false otherwise.
+func (e {{.EnumName}}) Valid() bool {
+	return e.index != 0
+}
+
+// Equal returns true if this enum and the given one represent the same enum
+// value.
+func (e {{.EnumName}}) Equal(other {{.EnumName}}) bool {
+	return e == other
+}
+
+// String implements the fmt.Stringer interface.
+func (e {{.EnumName}}) String() string {
+	return e.Name()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e {{.EnumName}}) MarshalJSON() ([]byte, error) {
+	if !e.Valid() {
+		return nil, fmt.Errorf("enum not initialized")
+	}
+
+	return json.Marshal(e.Name())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *{{.EnumName}}) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("source should be a string, got %s", data)
+	}
+
+	return e.UnmarshalText([]byte(name))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e {{.EnumName}}) MarshalText() ([]byte, error) {
+	if !e.Valid() {
+		return nil, fmt.Errorf("enum not initialized")
+	}
+
+	return []byte(e.Name()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *{{.EnumName}}) UnmarshalText(text []byte) error {
+	v, err := Parse{{.EnumName}}(string(text))
+	if err != nil {
+		return err
+	}
+
+	*e = v
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (e {{.EnumName}}) MarshalBinary() ([]byte, error) {
+	return e.MarshalText()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (e *{{.EnumName}}) UnmarshalBinary(data []byte) error {
+	return e.UnmarshalText(data)
+}
+
+// Value implements the driver.Valuer interface.
+func (e {{.EnumName}}) Value() (driver.Value, error) {
+	if !e.Valid() {
+		return nil, fmt.Errorf("enum not initialized")
+	}
+
+	return e.Name(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *{{.EnumName}}) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return e.UnmarshalText([]byte(v))
+	case []byte:
+		return e.UnmarshalText(v)
+	}
+
+	return fmt.Errorf("value is not a string or byte slice")
+}
+`))
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+const definitions = `
+- name: Unknown
+- name: Owner
+  id: 10
+  display_name: Owner
+  groups: [staff]
+  metadata:
+    icon: crown
+- name: Member
+- name: read-only
+  deprecated: true
+`
+
+func TestGenerateStatic(t *testing.T) {
+	enums, err := parseDefinitions([]byte(definitions))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	src, err := generateStatic(&staticPackage{
+		Package:  "roles",
+		TypeName: "Role",
+		EnumName: "RoleEnum",
+		Enums:    enums,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, expected := range []string{
+		"// Code generated by enumgen static -type Role; DO NOT EDIT.\n",
+		"UnknownID  Role = 0\n",
+		"OwnerID    Role = 10\n",
+		"MemberID   Role = 1\n",
+		"ReadOnlyID Role = 2\n",
+		"ReadOnly = RoleEnum{4}\n",
+		`case "read-only":`,
+		`"display_name": "Owner",`,
+		"func ParseRoleEnum(name string) (RoleEnum, error) {",
+		"func (e *RoleEnum) UnmarshalJSON(data []byte) error {",
+	} {
+		if !strings.Contains(string(src), expected) {
+			t.Errorf("expected generated source to contain %q, got:\n%s", expected, src)
+		}
+	}
+
+	fset := token.NewFileSet()
+
+	var files []*ast.File
+	for name, src := range map[string]string{"role.go": "package roles\n\ntype Role uint8\n", "role_static.go": string(src)} {
+		f, err := parser.ParseFile(fset, name, src, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		files = append(files, f)
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check("roles", fset, files, nil); err != nil {
+		t.Errorf("unexpected error type checking generated source: %s", err)
+	}
+}
+
+func TestParseDefinitions_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"empty", "[]"},
+		{"invalid", "name: A"},
+		{"no name", "- id: 1"},
+		{"duplicate name", "- name: A\n- name: A"},
+		{"duplicate identifier", "- name: a_b\n- name: A-B"},
+		{"invalid identifier", "- name: \"1\""},
+		{"duplicate id", "- name: A\n- name: B\n  id: 0"},
+		{"unknown field", "- name: A\n  display: A"},
+		{"validity period", "- name: A\n  valid_until: 2025-01-01T00:00:00Z"},
+		{"no document", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := parseDefinitions([]byte(test.src)); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Command enumlabels reports enum names that changed between two snapshots
+// of enum definitions. Names are commonly used as metrics labels, so renaming
+// or removing a value silently breaks dashboards and alerts that reference it.
+//
+// Usage:
+//
+//	enumlabels old new
+//
+// Snapshots are the golden files written by enumtest.AssertStable. Both
+// arguments can be files or directories, in which case files with the same
+// name in both directories are compared. Files only present in the new
+// directory are ignored. The exit status is 1 if any problem is found.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// snapshot is the format written by enumtest.AssertStable.
+type snapshot struct {
+	Type   string  `json:"type"`
+	Values []value `json:"values"`
+}
+
+type value struct {
+	Name string `json:"name"`
+
+	// ID is kept as written, as IDs of unsigned types may not fit in an
+	// int64.
+	ID json.Number `json:"id"`
+}
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: enumlabels old new")
+		os.Exit(2)
+	}
+
+	problems, err := compare(os.Args[1], os.Args[2])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "enumlabels: %s\n", err)
+		os.Exit(2)
+	}
+
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+
+	if len(problems) > 0 {
+		os.Exit(1)
+	}
+}
+
+// compare compares the snapshots in the given files or directories.
+func compare(oldPath, newPath string) ([]string, error) {
+	info, err := os.Stat(oldPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return compareFiles(oldPath, newPath)
+	}
+
+	entries, err := os.ReadDir(oldPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		fileProblems, err := compareFiles(filepath.Join(oldPath, entry.Name()), filepath.Join(newPath, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		problems = append(problems, fileProblems...)
+	}
+
+	return problems, nil
+}
+
+func compareFiles(oldPath, newPath string) ([]string, error) {
+	old, err := readSnapshot(oldPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(newPath); os.IsNotExist(err) {
+		return []string{fmt.Sprintf("%s: snapshot removed", old.Type)}, nil
+	}
+
+	current, err := readSnapshot(newPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff(old, current), nil
+}
+
+func readSnapshot(path string) (*snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var s snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("invalid snapshot %s: %w", path, err)
+	}
+
+	return &s, nil
+}
+
+// diff returns the problems found between the old and the current snapshots.
+func diff(old, current *snapshot) []string {
+	var problems []string
+
+	if old.Type != current.Type {
+		problems = append(problems, fmt.Sprintf("%s: type renamed to %s", old.Type, current.Type))
+	}
+
+	names := make(map[json.Number]string, len(current.Values))
+	ids := make(map[string]json.Number, len(current.Values))
+	for _, v := range current.Values {
+		names[v.ID] = v.Name
+		ids[v.Name] = v.ID
+	}
+
+	for _, v := range old.Values {
+		if _, ok := ids[v.Name]; ok {
+			continue
+		}
+
+		if name, ok := names[v.ID]; ok {
+			problems = append(problems, fmt.Sprintf("%s: %s (ID %s) renamed to %s", old.Type, v.Name, v.ID, name))
+		} else {
+			problems = append(problems, fmt.Sprintf("%s: %s (ID %s) removed", old.Type, v.Name, v.ID))
+		}
+	}
+
+	sort.Strings(problems)
+
+	return problems
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	oldDir, newDir := t.TempDir(), t.TempDir()
+
+	files := map[string]string{
+		filepath.Join(oldDir, "role.golden.json"): `{"type": "roles.Role", "values": [
+			{"name": "Unknown", "id": 0}, {"name": "Admin", "id": 1}, {"name": "Guest", "id": 2}, {"name": "Viewer", "id": 3}
+		]}`,
+		filepath.Join(newDir, "role.golden.json"): `{"type": "roles.Role", "values": [
+			{"name": "Unknown", "id": 0}, {"name": "Administrator", "id": 1}, {"name": "Guest", "id": 5}, {"name": "Owner", "id": 4}
+		]}`,
+		filepath.Join(oldDir, "status.golden.json"): `{"type": "roles.Status", "values": [{"name": "Active", "id": 0}]}`,
+		filepath.Join(newDir, "status.golden.json"): `{"type": "roles.Status", "values": [{"name": "Active", "id": 0}, {"name": "Disabled", "id": 1}]}`,
+		filepath.Join(oldDir, "plan.golden.json"):   `{"type": "roles.Plan", "values": [{"name": "Free", "id": 0}]}`,
+		filepath.Join(oldDir, "hash.golden.json"):   `{"type": "roles.Hash", "values": [{"name": "Sha", "id": 18446744073709551615}]}`,
+		filepath.Join(newDir, "hash.golden.json"):   `{"type": "roles.Hash", "values": [{"name": "SHA", "id": 18446744073709551615}]}`,
+	}
+
+	for path, data := range files {
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	problems, err := compare(oldDir, newDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"roles.Hash: Sha (ID 18446744073709551615) renamed to SHA",
+		"roles.Plan: snapshot removed",
+		"roles.Role: Admin (ID 1) renamed to Administrator",
+		"roles.Role: Viewer (ID 3) removed",
+	}
+
+	if len(problems) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, problems)
+	}
+
+	for i := range expected {
+		if problems[i] != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], problems[i])
+		}
+	}
+
+	problems, err = compare(filepath.Join(oldDir, "status.golden.json"), filepath.Join(newDir, "status.golden.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(problems) != 0 {
+		t.Errorf("expected no problems, got %v", problems)
+	}
+}
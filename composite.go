@@ -0,0 +1,85 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// CompositeSeparator separates the parts of the names of composite enums.
+const CompositeSeparator = ":"
+
+// NewComposite returns a new Enum associated with type T that is identified
+// by multiple parts (for example, a service and an action). Its name is the
+// parts joined with CompositeSeparator ("billing:read"), which is what is used
+// when marshaling and parsing it. The parts are available through the Parts
+// method. This panics if there are no parts or if any part is empty or
+// contains the separator.
+func NewComposite[T constraints.Integer](parts []string, opts ...Option) Enum[T] {
+	attrs := attributes{
+		parts:        append([]string(nil), parts...),
+		registeredAt: callSite(1),
+	}
+
+	s := getOrCreateSetForType[T]()
+
+	if len(parts) == 0 {
+		panic(s.compositeFailure("composite enum must have at least one part", parts, attrs))
+	}
+
+	for _, part := range parts {
+		if part == "" || strings.Contains(part, CompositeSeparator) {
+			panic(s.compositeFailure(fmt.Sprintf("invalid composite enum part %q", part), parts, attrs))
+		}
+	}
+
+	for _, opt := range opts {
+		opt(&attrs)
+	}
+
+	return Enum[T]{internalEnumWrapper[T]{s.Add(strings.Join(parts, CompositeSeparator), attrs)}}
+}
+
+// compositeFailure returns the value of panics for composite enums with the
+// given parts that can not be registered (see registrationFailure).
+func (s *internalSet[T]) compositeFailure(msg string, parts []string, attrs attributes) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.registrationFailure(msg, strings.Join(parts, CompositeSeparator), attrs)
+}
+
+// EnumByTypeAndParts is like EnumByTypeAndName but takes the parts of the
+// name of a composite enum.
+func EnumByTypeAndParts[T constraints.Integer](parts ...string) (Enum[T], error) {
+	return EnumByTypeAndName[T](strings.Join(parts, CompositeSeparator))
+}
+
+// Parts returns the parts of this Enum if it was created with NewComposite.
+// Otherwise, it returns a single part with its name.
+func (e internalEnumWrapper[T]) Parts() []string {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if e.internalEnum.parts == nil {
+		return []string{e.internalEnum.name}
+	}
+
+	return append([]string(nil), e.internalEnum.parts...)
+}
+
+// Part returns the i-th part of this Enum (see Parts). This panics if i is
+// out of range.
+func (e internalEnumWrapper[T]) Part(i int) string {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if e.internalEnum.parts == nil {
+		return []string{e.internalEnum.name}[i]
+	}
+
+	return e.internalEnum.parts[i]
+}
@@ -0,0 +1,50 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewComposite(t *testing.T) {
+	type permission int
+
+	billingRead := NewComposite[permission]([]string{"billing", "read"})
+	billingWrite := NewComposite[permission]([]string{"billing", "write"})
+	plain := New[permission]("admin")
+
+	if billingRead.Name() != "billing:read" {
+		t.Errorf("expected billing:read, got %s", billingRead.Name())
+	}
+
+	if parts := billingWrite.Parts(); len(parts) != 2 || parts[0] != "billing" || parts[1] != "write" {
+		t.Errorf("unexpected parts %v", parts)
+	}
+
+	if billingWrite.Part(1) != "write" || plain.Part(0) != "admin" || len(plain.Parts()) != 1 {
+		t.Errorf("unexpected parts")
+	}
+
+	e, err := EnumByTypeAndParts[permission]("billing", "write")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if e != billingWrite {
+		t.Errorf("expected %s, got %s", billingWrite, e)
+	}
+
+	if err := json.Unmarshal([]byte(`"billing:read"`), &e); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if e != billingRead {
+		t.Errorf("expected %s, got %s", billingRead, e)
+	}
+
+	for _, parts := range [][]string{nil, {"billing", ""}, {"billing:read"}} {
+		parts := parts
+		expectPanic(t, func() {
+			NewComposite[permission](parts)
+		})
+	}
+}
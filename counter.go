@@ -0,0 +1,148 @@
+package enum
+
+import (
+	"sync/atomic"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Counter counts events by enum value using a fixed-size array of atomic
+// counters, one per enum. It is safe for concurrent use and, contrary to a map
+// protected by a mutex, increments never block each other.
+//
+// The array holds all enums associated with type T at the time the Counter is
+// created. Increments for enums with negative IDs, for enums added later (like
+// unrecognized ones) and for enums not returned by EnumsByType (like retired
+// ones) are accumulated in a separate counter reported by Other.
+type Counter[T constraints.Integer] struct {
+	other uint64 // First field to guarantee 64-bit alignment.
+
+	counts []uint64
+	counterIndex[T]
+}
+
+// NewCounter returns a new Counter for enums associated with type T.
+func NewCounter[T constraints.Integer]() *Counter[T] {
+	index := newCounterIndex[T]()
+
+	return &Counter[T]{
+		counts:       make([]uint64, len(index.enums)),
+		counterIndex: index,
+	}
+}
+
+// counterIndex maps the enums handled by a counter to the indices of their
+// counts. It is not modified once created, so it can be read concurrently.
+type counterIndex[T constraints.Integer] struct {
+	// enums are the enums handled by the counter by index. Entries for
+	// unused IDs are invalid.
+	enums []Enum[T]
+
+	// byID maps IDs to indices if IDs are too sparse to be used as indices
+	// (for example, hashed IDs), or is nil.
+	byID map[T]int
+}
+
+// newCounterIndex returns the index of the enums associated with type T that
+// do not have negative IDs.
+func newCounterIndex[T constraints.Integer]() counterIndex[T] {
+	var all []Enum[T]
+	for _, e := range EnumsByType[T]() {
+		if e.ID() >= 0 {
+			all = append(all, e)
+		}
+	}
+
+	// IDs are used as indices as long as that does not waste much more space
+	// than one entry per enum, which is the case of IDs assigned by New.
+	limit := uint64(2*len(all) + 16)
+
+	var size int
+	for _, e := range all {
+		if uint64(e.ID()) >= limit {
+			byID := make(map[T]int, len(all))
+			for i, e := range all {
+				byID[e.ID()] = i
+			}
+
+			return counterIndex[T]{enums: all, byID: byID}
+		}
+
+		if int(e.ID()) >= size {
+			size = int(e.ID()) + 1
+		}
+	}
+
+	enums := make([]Enum[T], size)
+	for _, e := range all {
+		enums[e.ID()] = e
+	}
+
+	return counterIndex[T]{enums: enums}
+}
+
+// lookup returns the index of the given enum, or false if it is not handled.
+func (x *counterIndex[T]) lookup(value Member[T]) (int, bool) {
+	w := value.wrapper()
+	if !w.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	i := -1
+	if x.byID != nil {
+		if index, ok := x.byID[w.id]; ok {
+			i = index
+		}
+	} else if w.id >= 0 && uint64(w.id) < uint64(len(x.enums)) {
+		i = int(w.id)
+	}
+
+	if i < 0 || !x.enums[i].Valid() {
+		return 0, false
+	}
+
+	return i, true
+}
+
+// Inc increments the counter associated with the given enum by one.
+func (c *Counter[T]) Inc(value Member[T]) {
+	c.Add(value, 1)
+}
+
+// Add increments the counter associated with the given enum by n.
+func (c *Counter[T]) Add(value Member[T], n uint64) {
+	atomic.AddUint64(c.counter(value), n)
+}
+
+// Get returns the current count for the given enum.
+func (c *Counter[T]) Get(value Member[T]) uint64 {
+	return atomic.LoadUint64(c.counter(value))
+}
+
+// Other returns the sum of all increments for enums outside of the range of
+// IDs handled by this Counter.
+func (c *Counter[T]) Other() uint64 {
+	return atomic.LoadUint64(&c.other)
+}
+
+// Snapshot returns the current counts for all enums handled by this Counter,
+// including the ones that were never incremented. Each individual count is
+// read atomically but the snapshot as a whole is not.
+func (c *Counter[T]) Snapshot() map[Enum[T]]uint64 {
+	snapshot := make(map[Enum[T]]uint64, len(c.counts))
+	for i := range c.counts {
+		if c.enums[i].Valid() {
+			snapshot[c.enums[i]] = atomic.LoadUint64(&c.counts[i])
+		}
+	}
+
+	return snapshot
+}
+
+func (c *Counter[T]) counter(value Member[T]) *uint64 {
+	if i, ok := c.lookup(value); ok {
+		return &c.counts[i]
+	}
+
+	return &c.other
+}
@@ -0,0 +1,93 @@
+package enum
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	c := NewCounter[Role]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				c.Inc(Admin)
+				c.Add(User, 2)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got := c.Get(Admin); got != 1000 {
+		t.Errorf("expected 1000, got %d", got)
+	}
+	if got := c.Get(User); got != 2000 {
+		t.Errorf("expected 2000, got %d", got)
+	}
+
+	snapshot := c.Snapshot()
+	if len(snapshot) != 4 {
+		t.Errorf("expected 4 entries, got %d", len(snapshot))
+	}
+	if snapshot[Enum[Role](Admin)] != 1000 || snapshot[Enum[Role](Guest)] != 0 {
+		t.Errorf("unexpected snapshot %v", snapshot)
+	}
+}
+
+func TestCounter_Other(t *testing.T) {
+	type counterStatus int
+
+	active := New[counterStatus]("Active")
+
+	c := NewCounter[counterStatus]()
+
+	// Added after the counter was created.
+	late := New[counterStatus]("Late")
+
+	c.Inc(active)
+	c.Inc(late)
+	c.Inc(late)
+
+	if got := c.Get(active); got != 1 {
+		t.Errorf("expected 1, got %d", got)
+	}
+	if got := c.Other(); got != 2 {
+		t.Errorf("expected 2, got %d", got)
+	}
+}
+
+func BenchmarkCounter_Inc(b *testing.B) {
+	c := NewCounter[Role]()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.Inc(Admin)
+		}
+	})
+}
+
+func TestCounter_HashID(t *testing.T) {
+	type event uint64
+
+	var (
+		created = New[event]("Created", HashID())
+		deleted = New[event]("Deleted", HashID())
+	)
+
+	c := NewCounter[event]()
+	c.Inc(created)
+	c.Add(deleted, 2)
+
+	if c.Get(created) != 1 || c.Get(deleted) != 2 || c.Other() != 0 {
+		t.Errorf("unexpected counts %v (other %d)", c.Snapshot(), c.Other())
+	}
+
+	if snapshot := c.Snapshot(); len(snapshot) != 2 || snapshot[created] != 1 || snapshot[deleted] != 2 {
+		t.Errorf("unexpected snapshot %v", snapshot)
+	}
+}
@@ -0,0 +1,30 @@
+package enum
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Building with the enumdebug tag enables invariant checks that are too
+// expensive for production but surface bugs in CI and staging with precise
+// diagnostics:
+//
+//   - Equal panics if any of the compared values is not the registered
+//     instance of its enum (for example, a copy made by a deep copy library,
+//     which breaks == comparisons; see Canonical).
+//   - New panics for all types if enums of the same type were already looked
+//     up or marshaled, as if RejectLateRegistration was called for all types.
+//   - Panics for uninitialized enums include the stack trace of the caller in
+//     their message, so it is not lost when the panic is recovered and only
+//     its value is logged (as HTTP servers usually do).
+
+// checkCanonical panics if e is not the registered instance of its enum.
+func checkCanonical[T constraints.Integer](e *internalEnum[T]) {
+	if e.set == nil || e.set.Registered(e) {
+		return
+	}
+
+	panic(fmt.Sprintf("enum %s of type %s is a copy of the registered instance "+
+		"(== comparisons with it are always false; see Canonical)", e.name, getTypeName[T]()))
+}
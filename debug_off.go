@@ -0,0 +1,6 @@
+//go:build !enumdebug
+
+package enum
+
+// debugMode is true when building with the enumdebug tag (see debug.go).
+const debugMode = false
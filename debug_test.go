@@ -0,0 +1,50 @@
+//go:build enumdebug
+
+package enum
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDebug_Canonical(t *testing.T) {
+	type copied int
+
+	original := New[copied]("Original")
+	copy := Enum[copied]{internalEnumWrapper[copied]{&internalEnum[copied]{}}}
+	*copy.internalEnum = *original.internalEnum
+
+	if !original.Equal(original) {
+		t.Errorf("expected %s to equal itself", original)
+	}
+
+	expectPanic(t, func() {
+		original.Equal(copy)
+	})
+}
+
+func TestDebug_LateRegistration(t *testing.T) {
+	type lateDebug int
+
+	first := New[lateDebug]("First")
+	if _, err := first.MarshalText(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectPanic(t, func() {
+		New[lateDebug]("Second")
+	})
+}
+
+func TestDebug_NotInitialized(t *testing.T) {
+	defer func() {
+		msg := fmt.Sprint(recover())
+		if !strings.HasPrefix(msg, "enum not initialized (") || !strings.Contains(msg, "TestDebug_NotInitialized") {
+			t.Errorf("expected stack trace in %q", msg)
+		}
+	}()
+
+	var e Enum[Role]
+	_ = e.Name()
+}
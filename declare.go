@@ -0,0 +1,92 @@
+package enum
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Declare returns a new Enum associated with the given name and type T that
+// is only registered when Resolve is called for type T. It removes the
+// sensitivity to initialization order of New, which assigns IDs in the order
+// enums are created: when var blocks of the same type are spread across
+// files, that order depends on the file names and on the dependencies between
+// the variables. Enums declared with Declare get their IDs in the order of
+// their names instead:
+//
+//	// roles.go
+//	var Admin = enum.Declare[Role]("Admin")
+//
+//	// guests.go
+//	var Guest = enum.Declare[Role]("Guest")
+//
+//	// init.go
+//	func init() {
+//		enum.Resolve[Role]()
+//	}
+//
+// The returned Enum can be used immediately: it can be compared with ==, used
+// as a map key and Name and String return its name. However, its ID is 0, it
+// can not be looked up or decoded and marshaling it (as text, JSON, binary or
+// SQL values) returns a non-nil error until it is resolved. The
+// given options are applied when it is declared, but the enum (and the
+// errors, like duplicate names, that registering it can produce) is only
+// checked by Resolve.
+func Declare[T constraints.Integer](name string, opts ...Option) Enum[T] {
+	if name == "" {
+		panic(emptyName[T](callSite(1)))
+	}
+
+	attrs := attributes{registeredAt: callSite(1)}
+	for _, opt := range opts {
+		opt(&attrs)
+	}
+
+	return Enum[T]{internalEnumWrapper[T]{getOrCreateSetForType[T]().Declare(name, attrs)}}
+}
+
+// Resolve registers the enums associated with type T declared with Declare
+// since the last call, in the order of their names. Each of them gets the
+// next available ID, as with New, or its hashed ID (see HashID). Resolve
+// panics if an enum can not be registered, in the same cases as New.
+func Resolve[T constraints.Integer]() {
+	s := getOrCreateSetForType[T]()
+
+	declared := s.TakeDeclared()
+	sort.SliceStable(declared, func(i, j int) bool {
+		return declared[i].name < declared[j].name
+	})
+
+	for _, e := range declared {
+		s.AddDeclared(e)
+	}
+}
+
+// Declare creates a new enum with the given name and attributes that is not
+// added to the name and ID maps until it is registered by Resolve.
+func (s *internalSet[T]) Declare(name string, attrs attributes) *internalEnum[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := &internalEnum[T]{
+		name:       name,
+		set:        s,
+		unresolved: true,
+		attributes: attrs,
+	}
+
+	s.declared = append(s.declared, e)
+
+	return e
+}
+
+// TakeDeclared returns the enums created by Declare since the last call.
+func (s *internalSet[T]) TakeDeclared() []*internalEnum[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	declared := s.declared
+	s.declared = nil
+
+	return declared
+}
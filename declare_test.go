@@ -0,0 +1,90 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDeclare(t *testing.T) {
+	if debugMode {
+		t.Skip("late registration is rejected with the enumdebug build tag")
+	}
+
+	type region int
+
+	west := Declare[region]("West")
+	east := Declare[region]("East", Metadata("timezone", "EST"))
+	north := Declare[region]("North", HashID())
+
+	if west.Name() != "West" || west == east {
+		t.Errorf("unexpected handles %v and %v", west, east)
+	}
+
+	if _, err := EnumByTypeAndName[region]("West"); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if got := EnumsByType[region](); len(got) != 0 {
+		t.Errorf("expected no enums before Resolve, got %v", got)
+	}
+
+	if _, err := json.Marshal(west); err == nil {
+		t.Errorf("expected error marshaling before Resolve, got nil")
+	}
+
+	if _, err := west.MarshalBinary(); err == nil {
+		t.Errorf("expected error marshaling before Resolve, got nil")
+	}
+
+	if _, err := west.Value(); err == nil {
+		t.Errorf("expected error marshaling before Resolve, got nil")
+	}
+
+	Resolve[region]()
+
+	if data, err := json.Marshal(west); err != nil || string(data) != `"West"` {
+		t.Errorf("expected \"West\", got %s (%v)", data, err)
+	}
+
+	// IDs are assigned in the order of the names.
+	for e, id := range map[Enum[region]]region{east: 0, west: 1, north: hashID[region]("North")} {
+		if e.ID() != id {
+			t.Errorf("expected ID %d for %s, got %d", id, e, e.ID())
+		}
+
+		parsed, err := EnumByTypeAndName[region](e.Name())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if parsed != e {
+			t.Errorf("expected %v, got %v", e, parsed)
+		}
+	}
+
+	if tz, ok := east.MetadataValue("timezone"); !ok || tz != "EST" {
+		t.Errorf("expected EST, got %v", tz)
+	}
+
+	if err := ValidateStruct(struct{ Region Enum[region] }{west}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	// Resolving again only registers new declarations.
+	Resolve[region]()
+
+	south := Declare[region]("South")
+	Resolve[region]()
+
+	if south.ID() != 2 {
+		t.Errorf("expected ID 2 for %s, got %d", south, south.ID())
+	}
+
+	Declare[region]("West")
+
+	msg := panicMessage(func() {
+		Resolve[region]()
+	})
+
+	expectContains(t, msg, "duplicate name in enum set", "declare_test.go")
+}
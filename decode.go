@@ -0,0 +1,72 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// InvalidValueError is the error associated with a field (see FieldError)
+// holding a value that can not be decoded into an enum.
+type InvalidValueError struct {
+	// Type is the type of the field (an Enum[T] or a type derived from one).
+	Type reflect.Type
+
+	// Input is the value, as found in the input (for example, a quoted JSON
+	// string).
+	Input string
+
+	// Allowed are the names of the enums the value can be decoded into.
+	Allowed []string
+
+	Err error
+}
+
+// Error implements the error interface.
+func (e *InvalidValueError) Error() string {
+	return fmt.Sprintf("invalid value %s for %s (allowed values: %s): %s",
+		e.Input, e.Type, strings.Join(e.Allowed, ", "), e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *InvalidValueError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeJSON is like json.Unmarshal but reports all enum values in data that
+// can not be decoded at once, so API clients can fix every invalid field in a
+// single round trip. In that case, v is not modified and a FieldErrors is
+// returned with one InvalidValueError per value and paths in JSON Pointer
+// (RFC 6901) format. Otherwise, it returns the result of json.Unmarshal.
+func DecodeJSON(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return json.Unmarshal(data, v)
+	}
+
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	var errs FieldErrors
+	validateJSONValue(rv.Type(), doc, "", true, &errs)
+
+	if len(errs) > 0 {
+		sortFieldErrors(errs)
+
+		return errs
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// sortFieldErrors sorts the given errors by path, as they are collected in
+// map iteration order.
+func sortFieldErrors(errs FieldErrors) {
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Path < errs[j].Path
+	})
+}
@@ -0,0 +1,71 @@
+package enum
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDecodeJSON(t *testing.T) {
+	type member struct {
+		Role RoleEnum `json:"role"`
+	}
+
+	type request struct {
+		Role       RoleEnum       `json:"role"`
+		Permission PermissionEnum `json:"permission"`
+		Members    []member       `json:"members"`
+		Name       string         `json:"name"`
+	}
+
+	var r request
+
+	err := DecodeJSON([]byte(`{"role": "Root", "permission": "Execute", "members": [{"role": "Admin"}, {"role": 1}]}`), &r)
+
+	var errs FieldErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected FieldErrors, got %v", err)
+	}
+
+	paths := make([]string, 0, len(errs))
+	for _, fe := range errs {
+		paths = append(paths, fe.Path)
+	}
+
+	if got := strings.Join(paths, ","); got != "/members/1/role,/permission,/role" {
+		t.Errorf("unexpected paths %s", got)
+	}
+
+	var invalid *InvalidValueError
+	if !errors.As(errs[2], &invalid) {
+		t.Fatalf("expected InvalidValueError, got %v", errs[2])
+	}
+
+	if invalid.Input != `"Root"` || strings.Join(invalid.Allowed, ",") != "Unknown,Admin,User,Guest" {
+		t.Errorf("unexpected error %s", invalid)
+	}
+
+	if r.Role.Valid() {
+		t.Errorf("expected value not to be modified")
+	}
+
+	if err := DecodeJSON([]byte(`{"role": "Admin", "members": [{"role": "User"}], "name": "x"}`), &r); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if r.Role != Admin || r.Members[0].Role != User || r.Name != "x" {
+		t.Errorf("unexpected value %+v", r)
+	}
+
+	if err := DecodeJSON([]byte(`{"name": 1}`), &r); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if err := DecodeJSON([]byte(`{`), &r); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if err := DecodeJSON([]byte(`{}`), r); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
@@ -0,0 +1,191 @@
+package enum
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/exp/constraints"
+	"gopkg.in/yaml.v3"
+)
+
+// Metadata keys set by LoadDefinitions.
+const (
+	MetadataDisplayName = "display_name"
+	MetadataDescription = "description"
+)
+
+// definition is a single enum declared in a definitions file.
+type definition struct {
+	Name        string            `yaml:"name"`
+	ID          *int64            `yaml:"id"`
+	DisplayName string            `yaml:"display_name"`
+	Description string            `yaml:"description"`
+	Deprecated  bool              `yaml:"deprecated"`
+	Groups      []string          `yaml:"groups"`
+	Tags        []string          `yaml:"tags"`
+	Metadata    map[string]string `yaml:"metadata"`
+	ValidFrom   time.Time         `yaml:"valid_from"`
+	ValidUntil  time.Time         `yaml:"valid_until"`
+}
+
+// LoadDefinitions registers the enums associated with type T declared in the
+// given YAML (or JSON) document and returns them in declaration order. This
+// allows value lists to be maintained outside of Go code, usually in an
+// embedded file:
+//
+//	//go:embed roles.yaml
+//	var rolesYAML []byte
+//
+//	var roles = enum.MustLoadDefinitions[Role](rolesYAML)
+//
+// The document is a list of values:
+//
+//	# roles.yaml
+//	- name: Unknown
+//	- name: Admin
+//	  id: 10 # optional, defaults to the next available ID
+//	  display_name: Administrator
+//	  description: Can manage all resources.
+//	  groups: [staff]
+//	  tags: [billing]
+//	  metadata:
+//	    icon: shield
+//	- name: Moderator
+//	  deprecated: true
+//	  valid_until: 2025-01-01T00:00:00Z
+//
+// Display names and descriptions are available as metadata with the
+// MetadataDisplayName and MetadataDescription keys. This returns a non-nil
+// error (and registers nothing) if the document is invalid (including when it
+// has unknown fields) or declares a name or ID that is already registered.
+func LoadDefinitions[T constraints.Integer](data []byte) ([]Enum[T], error) {
+	return loadDefinitions[T](data, callSite(1))
+}
+
+// MustLoadDefinitions is like LoadDefinitions but panics on error. It is
+// intended to be used to initialize package level variables.
+func MustLoadDefinitions[T constraints.Integer](data []byte) []Enum[T] {
+	enums, err := loadDefinitions[T](data, callSite(1))
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return enums
+}
+
+// loadDefinitions implements LoadDefinitions. All enums are registered as
+// being registered at the given call site.
+func loadDefinitions[T constraints.Integer](data []byte, registeredAt string) ([]Enum[T], error) {
+	var defs []definition
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&defs); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("invalid enum definitions for type %s: %w", getTypeName[T](), err)
+	}
+
+	s := getOrCreateSetForType[T]()
+
+	if s.Frozen() {
+		return nil, fmt.Errorf("enum set for type %s is frozen", getTypeName[T]())
+	}
+
+	names := make(map[string]bool, len(defs))
+	ids := make(map[T]bool, len(defs))
+
+	for i, def := range defs {
+		if def.Name == "" {
+			return nil, fmt.Errorf("definition %d for type %s has no name", i, getTypeName[T]())
+		}
+
+		if names[def.Name] || s.Get(def.Name) != nil {
+			return nil, fmt.Errorf("duplicate name %s for type %s", def.Name, getTypeName[T]())
+		}
+
+		names[def.Name] = true
+
+		if def.ID == nil {
+			if s.ExplicitIDs() {
+				return nil, fmt.Errorf("definition %s for type %s has no id", def.Name, getTypeName[T]())
+			}
+
+			continue
+		}
+
+		// Negative IDs wrap around to valid unsigned IDs.
+		id := T(*def.ID)
+		if int64(id) != *def.ID || (*def.ID < 0 && !isSigned[T]()) {
+			return nil, fmt.Errorf("id %d out of range for type %s", *def.ID, getTypeName[T]())
+		}
+
+		if _, err := s.GetByID(id); err == nil || ids[id] {
+			return nil, fmt.Errorf("duplicate id %d for type %s", id, getTypeName[T]())
+		}
+
+		ids[id] = true
+	}
+
+	registrations := make([]Registration[T], 0, len(defs))
+	for _, def := range defs {
+		r := Registration[T]{name: def.Name, attrs: def.attributes(registeredAt)}
+		if def.ID != nil {
+			id := T(*def.ID)
+			r.id = &id
+		}
+
+		registrations = append(registrations, r)
+	}
+
+	// Registering could still panic (for example, for IDs not allowed by
+	// RequireContiguous) after some enums are registered.
+	if problems := Plan(registrations...); len(problems) > 0 {
+		return nil, fmt.Errorf("invalid enum definitions for type %s: %s", getTypeName[T](), problems[0].Message)
+	}
+
+	enums := make([]Enum[T], 0, len(defs))
+	for _, r := range registrations {
+		var e *internalEnum[T]
+		if r.id == nil {
+			e = s.Add(r.name, r.attrs)
+		} else {
+			e = s.AddWithID(r.name, *r.id, r.attrs)
+		}
+
+		enums = append(enums, Enum[T]{internalEnumWrapper[T]{e}})
+	}
+
+	return enums, nil
+}
+
+func (d definition) attributes(registeredAt string) attributes {
+	attrs := attributes{
+		deprecated:   d.Deprecated,
+		groups:       d.Groups,
+		tags:         d.Tags,
+		validFrom:    d.ValidFrom,
+		validUntil:   d.ValidUntil,
+		registeredAt: registeredAt,
+	}
+
+	opts := make([]Option, 0, len(d.Metadata)+2)
+	for key, value := range d.Metadata {
+		opts = append(opts, Metadata(key, value))
+	}
+
+	if d.DisplayName != "" {
+		opts = append(opts, Metadata(MetadataDisplayName, d.DisplayName))
+	}
+
+	if d.Description != "" {
+		opts = append(opts, Metadata(MetadataDescription, d.Description))
+	}
+
+	for _, opt := range opts {
+		opt(&attrs)
+	}
+
+	return attrs
+}
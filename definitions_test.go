@@ -0,0 +1,121 @@
+package enum
+
+import (
+	_ "embed"
+	"testing"
+)
+
+//go:embed testdata/roles.yaml
+var definedRolesYAML []byte
+
+type definedRole int
+
+var definedRoles = MustLoadDefinitions[definedRole](definedRolesYAML)
+
+func TestLoadDefinitions(t *testing.T) {
+	expected := []struct {
+		name string
+		id   definedRole
+	}{{"Unknown", 0}, {"Owner", 10}, {"Member", 1}, {"Moderator", 2}}
+
+	if len(definedRoles) != len(expected) {
+		t.Fatalf("expected %d enums, got %d", len(expected), len(definedRoles))
+	}
+
+	for i, e := range definedRoles {
+		if e.Name() != expected[i].name || e.ID() != expected[i].id {
+			t.Errorf("expected %s with ID %d, got %s with ID %d", expected[i].name, expected[i].id, e.Name(), e.ID())
+		}
+	}
+
+	owner := definedRoles[1]
+	if name, _ := owner.MetadataValue(MetadataDisplayName); name != "Owner" {
+		t.Errorf("expected display name Owner, got %q", name)
+	}
+
+	if icon, _ := owner.MetadataValue("icon"); icon != "crown" {
+		t.Errorf("expected icon crown, got %q", icon)
+	}
+
+	if !owner.InGroup("staff") || !owner.HasTag("billing") || !definedRoles[3].Deprecated() {
+		t.Errorf("unexpected attributes")
+	}
+}
+
+func TestLoadDefinitions_JSON(t *testing.T) {
+	type jsonRole int
+
+	enums, err := LoadDefinitions[jsonRole]([]byte(`[{"name": "A"}, {"name": "B", "id": 5}]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(enums) != 2 || enums[1].ID() != 5 {
+		t.Errorf("unexpected enums: %v", enums)
+	}
+}
+
+func TestLoadDefinitions_Errors(t *testing.T) {
+	type invalidRole int8
+
+	New[invalidRole]("Existing")
+
+	for _, data := range []string{
+		`not a list`,
+		`[{"id": 1}]`,
+		`[{"name": "A"}, {"name": "A"}]`,
+		`[{"name": "Existing"}]`,
+		`[{"name": "A", "id": 1}, {"name": "B", "id": 1}]`,
+		`[{"name": "A", "id": 0}]`,
+		`[{"name": "A", "id": 1000}]`,
+		`[{"name": "A", "display": "Typo"}]`,
+	} {
+		if _, err := LoadDefinitions[invalidRole]([]byte(data)); err == nil {
+			t.Errorf("expected error for %s, got nil", data)
+		}
+	}
+
+	if n := len(EnumsByType[invalidRole]()); n != 1 {
+		t.Errorf("expected invalid definitions not to be registered, got %d enums", n)
+	}
+}
+
+func TestLoadDefinitions_UnsignedNegativeID(t *testing.T) {
+	type unsignedRole uint64
+
+	if _, err := LoadDefinitions[unsignedRole]([]byte(`[{"name": "A", "id": -1}]`)); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if n := len(EnumsByType[unsignedRole]()); n != 0 {
+		t.Errorf("expected invalid definitions not to be registered, got %d enums", n)
+	}
+}
+
+func TestLoadDefinitions_Empty(t *testing.T) {
+	type emptyRole int
+
+	enums, err := LoadDefinitions[emptyRole](nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(enums) != 0 {
+		t.Errorf("expected no enums, got %d", len(enums))
+	}
+}
+
+func TestLoadDefinitions_Plan(t *testing.T) {
+	type contiguousRole int
+
+	RequireContiguous[contiguousRole]()
+
+	// The gap is only found when registering the second definition.
+	if _, err := LoadDefinitions[contiguousRole]([]byte(`[{"name": "A", "id": 0}, {"name": "B", "id": 2}]`)); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if n := len(EnumsByType[contiguousRole]()); n != 0 {
+		t.Errorf("expected invalid definitions not to be registered, got %d enums", n)
+	}
+}
@@ -0,0 +1,89 @@
+package enum
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// deprecatedPolicy is the policy for marshaling deprecated enums.
+type deprecatedPolicy int
+
+const (
+	deprecatedAllow deprecatedPolicy = iota
+	deprecatedWarn
+	deprecatedReplace
+	deprecatedReject
+)
+
+// The functions below set the policy for marshaling deprecated enums
+// associated with type T (see the Deprecated option), for types whose
+// deprecated values should stop being written while they can still be read.
+// The policy applies when marshaling as text, JSON, binary or SQL values, with
+// a profile (see MarshalTextProfile), with ToWire and with AppendBinary, in
+// a Slice, UniqueSlice or Set and with DictionaryEncode.
+// Decoding is never affected. Each call replaces the previous policy.
+
+// AllowDeprecated makes deprecated enums associated with type T be marshaled
+// like any other (the default).
+func AllowDeprecated[T constraints.Integer]() {
+	getOrCreateSetForType[T]().SetDeprecatedPolicy(deprecatedAllow, nil)
+}
+
+// WarnDeprecated makes marshaling a deprecated enum associated with type T
+// call the given function with it (for example, to log or count writes that
+// should be migrated) before it is marshaled as usual.
+func WarnDeprecated[T constraints.Integer](hook func(Value)) {
+	if hook == nil {
+		panic("nil deprecated hook")
+	}
+
+	getOrCreateSetForType[T]().SetDeprecatedPolicy(deprecatedWarn, hook)
+}
+
+// ReplaceDeprecated makes deprecated enums associated with type T be
+// marshaled as their canonical values (see Canonicalize). Marshaling a
+// deprecated enum that was neither superseded nor retired fails.
+func ReplaceDeprecated[T constraints.Integer]() {
+	getOrCreateSetForType[T]().SetDeprecatedPolicy(deprecatedReplace, nil)
+}
+
+// RejectDeprecated makes marshaling a deprecated enum associated with type T
+// fail.
+func RejectDeprecated[T constraints.Integer]() {
+	getOrCreateSetForType[T]().SetDeprecatedPolicy(deprecatedReject, nil)
+}
+
+// marshaled marks the enum as used and returns the enum to marshal in its
+// place, according to the policy for deprecated enums. Enums that are not
+// resolved yet (see Declare) can not be marshaled.
+func (e *internalEnum[T]) marshaled() (*internalEnum[T], error) {
+	e.markUsed()
+
+	if e.unresolved {
+		return nil, fmt.Errorf("enum %s of type %s is declared but not resolved (see Resolve)", e.name, getTypeName[T]())
+	}
+
+	if !e.deprecated || e.set == nil {
+		return e, nil
+	}
+
+	policy, hook := e.set.DeprecatedPolicy()
+
+	switch policy {
+	case deprecatedWarn:
+		hook(Enum[T]{internalEnumWrapper[T]{e}})
+	case deprecatedReplace:
+		r := e.set.Canonicalize(e)
+		if r == e {
+			return nil, fmt.Errorf("deprecated enum %s of type %s has no replacement (see Retire and Supersede)",
+				e.name, getTypeName[T]())
+		}
+
+		return r, nil
+	case deprecatedReject:
+		return nil, fmt.Errorf("enum %s of type %s is deprecated and can not be marshaled", e.name, getTypeName[T]())
+	}
+
+	return e, nil
+}
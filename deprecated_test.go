@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDeprecatedPolicies(t *testing.T) {
+	type deprecatedStatus int
+
+	active := New[deprecatedStatus]("Active")
+	legacy := New[deprecatedStatus]("Legacy", Deprecated())
+	old := New[deprecatedStatus]("Old", Deprecated())
+	Retire(old, active)
+
+	marshal := func(e Enum[deprecatedStatus]) (string, error) {
+		data, err := json.Marshal(e)
+		return string(data), err
+	}
+
+	if got, err := marshal(legacy); err != nil || got != `"Legacy"` {
+		t.Errorf("expected \"Legacy\", got %s (%v)", got, err)
+	}
+
+	var warned []string
+	WarnDeprecated[deprecatedStatus](func(v Value) {
+		warned = append(warned, v.Name())
+	})
+
+	if got, err := marshal(legacy); err != nil || got != `"Legacy"` {
+		t.Errorf("expected \"Legacy\", got %s (%v)", got, err)
+	}
+
+	if _, err := marshal(active); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(warned) != 1 || warned[0] != "Legacy" {
+		t.Errorf("expected a warning for Legacy, got %v", warned)
+	}
+
+	ReplaceDeprecated[deprecatedStatus]()
+
+	if got, err := marshal(old); err != nil || got != `"Active"` {
+		t.Errorf("expected \"Active\", got %s (%v)", got, err)
+	}
+
+	if id, err := ToWire[int32](old); err != nil || id != int32(active.ID()) {
+		t.Errorf("expected %d, got %d (%v)", active.ID(), id, err)
+	}
+
+	if _, err := marshal(legacy); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	RejectDeprecated[deprecatedStatus]()
+
+	if _, err := legacy.MarshalText(); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if _, err := legacy.Value(); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if _, err := AppendBinary(nil, legacy, BinaryID); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	var decoded Enum[deprecatedStatus]
+	if err := json.Unmarshal([]byte(`"Legacy"`), &decoded); err != nil || decoded != legacy {
+		t.Errorf("expected decoding to not be affected, got %v (%v)", decoded, err)
+	}
+
+	AllowDeprecated[deprecatedStatus]()
+
+	if got, err := marshal(legacy); err != nil || got != `"Legacy"` {
+		t.Errorf("expected \"Legacy\", got %s (%v)", got, err)
+	}
+}
@@ -0,0 +1,129 @@
+package enum
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+
+	"golang.org/x/exp/constraints"
+)
+
+// TypeDescription describes the enums associated with a type. It is returned
+// by Describe.
+type TypeDescription struct {
+	// Type is the type T associated with the enums.
+	Type reflect.Type
+
+	// Values describes the enums sorted by ID, as returned by EnumsByType.
+	Values []ValueDescription
+}
+
+// ValueDescription describes an enum.
+type ValueDescription struct {
+	Name string
+
+	// ID is the decimal representation of the ID, which holds IDs of all
+	// integer types.
+	ID string
+
+	Deprecated bool
+	Groups     []string
+	Tags       []string
+	Metadata   map[string]string
+}
+
+// describer is implemented by all sets.
+type describer interface {
+	describe() []ValueDescription
+}
+
+// Describe returns descriptions of the enums of the given types, or of all
+// types with registered enums if none are given, sorted by type name. It is
+// intended for tooling like developer portals and documentation generators.
+// Metadata loaders (see MetadataLoader) are called, if needed.
+func Describe(types ...TypeSelector) []TypeDescription {
+	setByTypeMu.RLock()
+	sets := make(map[reflect.Type]any, len(setByType))
+	if len(types) == 0 {
+		for t, s := range setByType {
+			sets[t] = s
+		}
+	} else {
+		for _, selector := range types {
+			sets[selector.t] = setByType[selector.t]
+		}
+	}
+	setByTypeMu.RUnlock()
+
+	descriptions := make([]TypeDescription, 0, len(sets))
+	for t, s := range sets {
+		var values []ValueDescription
+		if s != nil {
+			values = s.(describer).describe()
+		}
+
+		if len(values) == 0 && len(types) == 0 {
+			continue
+		}
+
+		descriptions = append(descriptions, TypeDescription{Type: t, Values: values})
+	}
+
+	sort.Slice(descriptions, func(i, j int) bool {
+		return descriptions[i].Type.String() < descriptions[j].Type.String()
+	})
+
+	return descriptions
+}
+
+func (s *internalSet[T]) describe() []ValueDescription {
+	enums := s.All()
+	sort.Slice(enums, func(i, j int) bool {
+		return enums[i].id < enums[j].id
+	})
+
+	values := make([]ValueDescription, 0, len(enums))
+	for _, e := range enums {
+		w := internalEnumWrapper[T]{e}
+
+		values = append(values, ValueDescription{
+			Name:       e.name,
+			ID:         formatID(e.id),
+			Deprecated: e.deprecated,
+			Groups:     w.Groups(),
+			Tags:       w.Tags(),
+			Metadata:   w.Metadata(),
+		})
+	}
+
+	return values
+}
+
+// formatID returns the decimal representation of id.
+func formatID[T constraints.Integer](id T) string {
+	if id < 0 {
+		return strconv.FormatInt(int64(id), 10)
+	}
+
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// parseID parses the decimal representation of an ID of type T (as returned
+// by formatID). It returns false if s is not a valid ID of type T.
+func parseID[T constraints.Integer](s string) (T, bool) {
+	if isSigned[T]() {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || int64(T(id)) != id {
+			return 0, false
+		}
+
+		return T(id), true
+	}
+
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil || uint64(T(id)) != id {
+		return 0, false
+	}
+
+	return T(id), true
+}
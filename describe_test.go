@@ -0,0 +1,57 @@
+package enum
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	type documented int8
+
+	New[documented]("Low", Group("basic"), Tag("a"))
+	New[documented]("High", Deprecated(), Metadata("label", "High!"))
+	NewWithID[documented]("Negative", -1)
+
+	descriptions := Describe(TypeOf[documented](), TypeOf[Role]())
+	if len(descriptions) != 2 {
+		t.Fatalf("expected 2 descriptions, got %d", len(descriptions))
+	}
+
+	var d TypeDescription
+	for _, description := range descriptions {
+		if description.Type == reflect.TypeOf(documented(0)) {
+			d = description
+		}
+	}
+
+	expected := []ValueDescription{
+		{Name: "Negative", ID: "-1", Metadata: map[string]string{}},
+		{Name: "Low", ID: "0", Groups: []string{"basic"}, Tags: []string{"a"}, Metadata: map[string]string{}},
+		{Name: "High", ID: "1", Deprecated: true, Metadata: map[string]string{"label": "High!"}},
+	}
+
+	if !reflect.DeepEqual(d.Values, expected) {
+		t.Errorf("expected %+v, got %+v", expected, d.Values)
+	}
+
+	found := false
+	for _, description := range Describe() {
+		if description.Type == reflect.TypeOf(Role(0)) {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("expected all types to be described")
+	}
+}
+
+func TestFormatID(t *testing.T) {
+	if id := formatID(^uint64(0)); id != "18446744073709551615" {
+		t.Errorf("unexpected id %s", id)
+	}
+
+	if id := formatID(int64(-5)); id != "-5" {
+		t.Errorf("unexpected id %s", id)
+	}
+}
@@ -0,0 +1,77 @@
+package enum
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// DictionaryEncode encodes the given values as indices into a dictionary of
+// names, in the order they first appear. This is the layout used by
+// dictionary-encoded columns in Arrow (a DictionaryArray with int32 indices
+// and a string dictionary) and Parquet, so it can be passed to their builders
+// without converting every value to a string. Names are encoded like by
+// MarshalText (see RejectDeprecated and CharEncoded). Values that are not
+// valid are encoded as -1 (usually written as null). This returns a non-nil
+// error if a value can not be marshaled.
+func DictionaryEncode[T constraints.Integer](values []Enum[T]) (indices []int32, dictionary []string, err error) {
+	indices = make([]int32, len(values))
+	positions := make(map[*internalEnum[T]]int32)
+
+	for i, v := range values {
+		if !v.Valid() {
+			indices[i] = -1
+			continue
+		}
+
+		m, err := v.marshaled()
+		if err != nil {
+			return nil, nil, fmt.Errorf("value at position %d: %w", i, err)
+		}
+
+		pos, ok := positions[m]
+		if !ok {
+			pos = int32(len(dictionary))
+			positions[m] = pos
+			dictionary = append(dictionary, m.encodedName())
+		}
+
+		indices[i] = pos
+	}
+
+	return indices, dictionary, nil
+}
+
+// DictionaryDecode decodes values encoded with DictionaryEncode (or read from
+// a dictionary-encoded column). Names in the dictionary are decoded like by
+// UnmarshalText and each is only looked up once. Negative indices decode to
+// zero (invalid) values. This returns a non-nil error if an index is out of
+// range or the dictionary has an invalid name that is referenced.
+func DictionaryDecode[T constraints.Integer](indices []int32, dictionary []string) ([]Enum[T], error) {
+	decoded := make([]*internalEnum[T], len(dictionary))
+
+	values := make([]Enum[T], len(indices))
+	for i, index := range indices {
+		if index < 0 {
+			continue
+		}
+
+		if int(index) >= len(dictionary) {
+			return nil, fmt.Errorf("index %d at position %d out of range for dictionary of size %d", index, i, len(dictionary))
+		}
+
+		e := decoded[index]
+		if e == nil {
+			var err error
+			if e, err = parseInternalEnum[T](dictionary[index]); err != nil {
+				return nil, err
+			}
+
+			decoded[index] = e
+		}
+
+		values[i] = Enum[T]{internalEnumWrapper[T]{e}}
+	}
+
+	return values, nil
+}
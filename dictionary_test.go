@@ -0,0 +1,73 @@
+package enum
+
+import "testing"
+
+func TestDictionary(t *testing.T) {
+	values := []Enum[Role]{Enum[Role](Guest), Enum[Role](Admin), Enum[Role](Guest), {}, Enum[Role](User), Enum[Role](Admin)}
+
+	indices, dictionary, err := DictionaryEncode(values)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedIndices := []int32{0, 1, 0, -1, 2, 1}
+	for i := range expectedIndices {
+		if indices[i] != expectedIndices[i] {
+			t.Fatalf("expected indices %v, got %v", expectedIndices, indices)
+		}
+	}
+
+	if len(dictionary) != 3 || dictionary[0] != "Guest" || dictionary[1] != "Admin" || dictionary[2] != "User" {
+		t.Errorf("unexpected dictionary %v", dictionary)
+	}
+
+	decoded, err := DictionaryDecode[Role](indices, dictionary)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i := range values {
+		if decoded[i] != values[i] {
+			t.Errorf("expected %v at position %d, got %v", values[i], i, decoded[i])
+		}
+	}
+}
+
+func TestDictionaryEncode_Deprecated(t *testing.T) {
+	type dictionaryStatus int
+
+	active := New[dictionaryStatus]("Active")
+	old := New[dictionaryStatus]("Old", Deprecated())
+	legacy := New[dictionaryStatus]("Legacy", Deprecated())
+	Retire(old, active)
+
+	ReplaceDeprecated[dictionaryStatus]()
+
+	indices, dictionary, err := DictionaryEncode([]Enum[dictionaryStatus]{old, active})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(dictionary) != 1 || dictionary[0] != "Active" || indices[0] != 0 || indices[1] != 0 {
+		t.Errorf("unexpected encoding %v %v", indices, dictionary)
+	}
+
+	if _, _, err := DictionaryEncode([]Enum[dictionaryStatus]{legacy}); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestDictionaryDecode_Errors(t *testing.T) {
+	if _, err := DictionaryDecode[Role]([]int32{0, 1}, []string{"Admin"}); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if _, err := DictionaryDecode[Role]([]int32{0}, []string{"Missing"}); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	// Unreferenced names are not decoded.
+	if _, err := DictionaryDecode[Role]([]int32{1}, []string{"Missing", "Admin"}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
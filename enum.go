@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
 
 	"golang.org/x/exp/constraints"
 )
@@ -23,8 +26,29 @@ type Enum[T constraints.Integer] struct {
 
 // We need to use any here because each set will have a different type. This is
 // ok though as we will always know the exact type stored and will always
-// expose it as the actual type.
-var setByTypeName = make(map[string]any)
+// expose it as the actual type. Sets are keyed by the reflect.Type of T as
+// names are not unique (for example, for types declared inside functions).
+var (
+	setByTypeMu sync.RWMutex
+	setByType   = make(map[reflect.Type]any)
+)
+
+var enumPkgPath = reflect.TypeOf(internalEnumWrapper[int]{}).PkgPath()
+
+// isEnumType returns true if t is Enum[T] or a type derived from it. Types
+// that merely embed one of those are not considered enum types.
+func isEnumType(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || t.NumField() != 1 {
+		return false
+	}
+
+	f := t.Field(0)
+
+	// Checking the field name rules out structs embedding an Enum[T] (or a
+	// type derived from it) declared in this package.
+	return f.Anonymous && f.Name == "internalEnumWrapper" && f.Type.PkgPath() == enumPkgPath &&
+		reflect.PtrTo(f.Type).Implements(enumDecoderType)
+}
 
 // getTypeName returns the unique name of the associated type T.
 func getTypeName[T any]() string {
@@ -35,14 +59,22 @@ func getTypeName[T any]() string {
 	return tType.PkgPath() + "." + tType.Name()
 }
 
+// getType returns the reflect.Type of the associated type T.
+func getType[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
 func getOrCreateSetForType[T constraints.Integer]() *internalSet[T] {
-	typeName := getTypeName[T]()
+	t := getType[T]()
+
+	setByTypeMu.Lock()
+	defer setByTypeMu.Unlock()
 
 	var s *internalSet[T]
 
-	if as, ok := setByTypeName[typeName]; !ok {
+	if as, ok := setByType[t]; !ok {
 		s = newInternalSet[T]()
-		setByTypeName[typeName] = s
+		setByType[t] = s
 	} else {
 		s = as.(*internalSet[T])
 	}
@@ -50,35 +82,83 @@ func getOrCreateSetForType[T constraints.Integer]() *internalSet[T] {
 	return s
 }
 
-// New returns a new Enum associated with the given name and type T.
-func New[T constraints.Integer](name string) Enum[T] {
+// getSetForType returns the set associated with type T. If there is no such
+// set, a non-nil error is returned.
+func getSetForType[T constraints.Integer]() (*internalSet[T], error) {
+	setByTypeMu.RLock()
+	as, ok := setByType[getType[T]()]
+	setByTypeMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no enum set associated with type %s", getTypeName[T]())
+	}
+
+	return as.(*internalSet[T]), nil
+}
+
+// New returns a new Enum associated with the given name and type T. The given
+// options, if any, are applied to the new Enum.
+func New[T constraints.Integer](name string, opts ...Option) Enum[T] {
 	if name == "" {
-		panic("enum name cannot be empty")
+		panic(emptyName[T](callSite(1)))
+	}
+
+	attrs := attributes{registeredAt: callSite(1)}
+	for _, opt := range opts {
+		opt(&attrs)
 	}
 
 	s := getOrCreateSetForType[T]()
 
-	return Enum[T]{internalEnumWrapper[T]{s.Add(name)}}
+	if attrs.hashID {
+		return Enum[T]{internalEnumWrapper[T]{s.AddWithID(name, hashID[T](name), attrs)}}
+	}
+
+	return Enum[T]{internalEnumWrapper[T]{s.Add(name, attrs)}}
 }
 
-// EnumsByType returns all enums associated with the given type T.
+// EnumsByType returns all enums associated with the given type T sorted by ID.
+// Unrecognized enums (see Open) and retired enums (see Retire) are not
+// included. The returned slice is a copy that callers are free to modify.
 func EnumsByType[T constraints.Integer]() []Enum[T] {
-	s := setByTypeName[getTypeName[T]()]
+	s, err := getSetForType[T]()
+	if err != nil {
+		return nil
+	}
 
-	nameEnumMap := s.(*internalSet[T]).nameEnumMap
+	internalEnums := s.All()
+	sort.Slice(internalEnums, func(i, j int) bool {
+		return internalEnums[i].id < internalEnums[j].id
+	})
 
-	enums := make([]Enum[T], 0, len(nameEnumMap))
-	for _, e := range nameEnumMap {
+	enums := make([]Enum[T], 0, len(internalEnums))
+	for _, e := range internalEnums {
 		enums = append(enums, Enum[T]{internalEnumWrapper[T]{e}})
 	}
 
 	return enums
 }
 
+// Names returns the names of the enums returned by EnumsByType for type T, in
+// the same order. This is useful to list the allowed values of a field or a
+// flag (for example, in error messages or usage texts).
+func Names[T constraints.Integer]() []string {
+	enums := EnumsByType[T]()
+
+	names := make([]string, 0, len(enums))
+	for _, e := range enums {
+		names = append(names, e.Name())
+	}
+
+	return names
+}
+
 // EnumByTypeAndName returns the enum associated with the given type and name.
-// If there is no such enum, a non-nil error is returned.
+// If there is no such enum, a non-nil error is returned (unless type T is
+// open, in which case an unrecognized enum is returned).
+// Lookups of registered names do not allocate (see TestAllocations).
 func EnumByTypeAndName[T constraints.Integer](name string) (Enum[T], error) {
-	e, err := getInternalEnumForName[T](name)
+	e, err := parseInternalEnum[T](name)
 	if err != nil {
 		return Enum[T]{}, err
 	}
@@ -86,15 +166,54 @@ func EnumByTypeAndName[T constraints.Integer](name string) (Enum[T], error) {
 	return Enum[T]{internalEnumWrapper[T]{e}}, nil
 }
 
+// Canonical returns the registered instance of the given enum value. This is
+// useful to restore == semantics for values that were produced by deep copy
+// libraries (which allocate new internal state instead of sharing the
+// registered one). If the value is not valid or is not registered, a non-nil
+// error is returned.
+func Canonical[E EnumType[T], T constraints.Integer](v E) (E, error) {
+	w := struct{ internalEnumWrapper[T] }(v).internalEnumWrapper
+	if !w.Valid() {
+		return v, fmt.Errorf("enum not initialized")
+	}
+
+	e, err := getInternalEnumForName[T](w.name)
+	if err != nil {
+		return v, err
+	}
+
+	if e.id != w.id {
+		return v, fmt.Errorf("enum %s has id %d but registered one has id %d", w.name, w.id, e.id)
+	}
+
+	return E(struct{ internalEnumWrapper[T] }{internalEnumWrapper[T]{e}}), nil
+}
+
+// EnumType is a constraint satisfied by Enum[T] and by every type derived from
+// it (type RoleEnum Enum[Role]).
+type EnumType[T constraints.Integer] interface {
+	~struct{ internalEnumWrapper[T] }
+}
+
+// Member is implemented by Enum[T] and by every type derived from it. It allows
+// methods to accept any of those types without explicit conversions.
+type Member[T constraints.Integer] interface {
+	wrapper() internalEnumWrapper[T]
+}
+
 // internalEnumWrapper is the type that implements all Enum methods.
 type internalEnumWrapper[T constraints.Integer] struct {
 	*internalEnum[T]
 }
 
+func (e internalEnumWrapper[T]) wrapper() internalEnumWrapper[T] {
+	return e
+}
+
 // Name returns the name associated with this Enum instance.
 func (e internalEnumWrapper[T]) Name() string {
-	if !e.Valid() {
-		panic("enum not initialized")
+	if e.internalEnum == nil {
+		panicNotInitialized[T]()
 	}
 
 	return e.internalEnum.name
@@ -102,13 +221,82 @@ func (e internalEnumWrapper[T]) Name() string {
 
 // ID returns the numeric ID associated with this Enum instance.
 func (e internalEnumWrapper[T]) ID() T {
-	if !e.Valid() {
-		panic("enum not initialized")
+	if e.internalEnum == nil {
+		panicNotInitialized[T]()
 	}
 
 	return e.internalEnum.id
 }
 
+// Deprecated returns true if this Enum was created with the Deprecated option.
+func (e internalEnumWrapper[T]) Deprecated() bool {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return e.internalEnum.deprecated
+}
+
+// Groups returns the groups this Enum was added to with the Group option.
+func (e internalEnumWrapper[T]) Groups() []string {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return append([]string(nil), e.internalEnum.groups...)
+}
+
+// InGroup returns true if this Enum was added to the given group.
+func (e internalEnumWrapper[T]) InGroup(group string) bool {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return contains(e.internalEnum.groups, group)
+}
+
+// Tags returns the tags associated with this Enum with the Tag option.
+func (e internalEnumWrapper[T]) Tags() []string {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return append([]string(nil), e.internalEnum.tags...)
+}
+
+// HasTag returns true if this Enum is associated with the given tag.
+func (e internalEnumWrapper[T]) HasTag(tag string) bool {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return contains(e.internalEnum.tags, tag)
+}
+
+// Retired returns true if this Enum was retired with Retire.
+func (e internalEnumWrapper[T]) Retired() bool {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	s, err := getSetForType[T]()
+	if err != nil {
+		return false
+	}
+
+	return s.Retired(s.Get(e.name))
+}
+
+// Unrecognized returns true if this Enum was not registered with New but was
+// dynamically added when decoding an unknown name for an open type (see Open).
+func (e internalEnumWrapper[T]) Unrecognized() bool {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return e.internalEnum.unrecognized
+}
+
 // Valid returns true if the Enum is valid or false otherwise. Default Enum
 // instances are invalid. Use New to create a valid one (or use the
 // unmarshalling methods to initialize one created in place).
@@ -116,35 +304,179 @@ func (e *internalEnumWrapper[T]) Valid() bool {
 	return e.internalEnum != nil
 }
 
+// Equal returns true if this Enum and the given one represent the same enum
+// value. Contrary to ==, this does not require both values to share the same
+// internal pointer so it also works for values produced by deep copy libraries.
+// Having this method also allows go-cmp to compare Enums directly.
+func (e internalEnumWrapper[T]) Equal(other Member[T]) bool {
+	if other == nil {
+		return !e.Valid()
+	}
+
+	o := other.wrapper()
+	if !e.Valid() || !o.Valid() {
+		return e.Valid() == o.Valid()
+	}
+
+	if debugMode {
+		checkCanonical(e.internalEnum)
+		checkCanonical(o.internalEnum)
+	}
+
+	return e.internalEnum == o.internalEnum || (e.id == o.id && e.name == o.name)
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 func (e internalEnumWrapper[T]) MarshalJSON() ([]byte, error) {
 	if !e.Valid() {
 		return nil, fmt.Errorf("enum not initialized")
 	}
 
-	return json.Marshal(e.Name())
+	m, err := e.marshaled()
+	if err != nil {
+		return nil, err
+	}
+
+	return appendJSONString(nil, m.encodedName()), nil
 }
 
-func getInternalEnumForName[T constraints.Integer](name string) (*internalEnum[T], error) {
-	typeName := getTypeName[T]()
+// appendJSONString appends the JSON encoding of s to dst, the same way
+// json.Marshal does, with a single allocation for the common case of ASCII
+// names without characters that need escaping.
+func appendJSONString(dst []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c >= 0x80 || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			data, _ := json.Marshal(s)
 
-	anySet, ok := setByTypeName[typeName]
-	if !ok {
-		return nil, fmt.Errorf("no enum set associated with type %s", typeName)
+			return append(dst, data...)
+		}
+	}
+
+	if dst == nil {
+		dst = make([]byte, 0, len(s)+2)
 	}
 
-	s := anySet.(*internalSet[T])
+	dst = append(dst, '"')
+	dst = append(dst, s...)
+
+	return append(dst, '"')
+}
+
+func getInternalEnumForName[T constraints.Integer](name string) (*internalEnum[T], error) {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return nil, err
+	}
 
 	var e *internalEnum[T]
 	if e = s.Get(name); e == nil {
-		return nil, fmt.Errorf("name %s could not be found in enum set for type %s", name, typeName)
+		return nil, fmt.Errorf("name %s could not be found in enum set for type %s", name, getTypeName[T]())
+	}
+
+	return e, nil
+}
+
+// parseInternalEnum is like getInternalEnumForName but it is used for names
+// coming from external inputs. If type T is open, unknown names are added to
+// the set as unrecognized enums.
+func parseInternalEnum[T constraints.Integer](name string) (*internalEnum[T], error) {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	s.MarkUsed()
+
+	if e, ok, err := parseRegistered(s, name); ok {
+		return e, err
+	}
+
+	if s.Open() {
+		return s.AddUnrecognized(name)
+	}
+
+	return nil, fmt.Errorf("name %s could not be found in enum set for type %s", name, getTypeName[T]())
+}
+
+// parseRegistered is like parseInternalEnum but it never adds unrecognized
+// enums to the set. It returns false if the name is not registered.
+func parseRegistered[T constraints.Integer](s *internalSet[T], name string) (*internalEnum[T], bool, error) {
+	if e := s.Get(name); e != nil {
+		e, err := resolveDecoded(s, e)
+		return e, true, err
+	}
+
+	if e := s.Unknown(); e != nil && name == "" {
+		return e, true, nil
+	}
+
+	if e := s.getChar(name); e != nil {
+		e, err := resolveDecoded(s, e)
+		return e, true, err
+	}
+
+	return nil, false, nil
+}
+
+// parseInternalEnumBytes is like parseInternalEnum but takes the name as a
+// byte slice and does not allocate when it is registered (and there is no
+// normalizer).
+func parseInternalEnumBytes[T constraints.Integer](name []byte) (*internalEnum[T], error) {
+	if s, err := getSetForType[T](); err == nil {
+		if e := s.GetBytes(name); e != nil {
+			s.MarkUsed()
+
+			return resolveDecoded(s, e)
+		}
+	}
+
+	return parseInternalEnum[T](string(name))
+}
+
+func getInternalEnumForID[T constraints.Integer](id T) (*internalEnum[T], error) {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	s.MarkUsed()
+
+	e, err := s.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("id %d could not be found in enum set for type %s", id, getTypeName[T]())
+	}
+
+	if err := s.CheckLifecycle(e); err != nil {
+		return nil, err
 	}
 
 	return e, nil
 }
 
+// resolveDecoded returns the enum to use in place of the given enum decoded
+// from an external input (see Resolve) after applying the policy for its
+// lifecycle stage (see RejectLifecycle).
+func resolveDecoded[T constraints.Integer](s *internalSet[T], e *internalEnum[T]) (*internalEnum[T], error) {
+	if err := s.CheckLifecycle(e); err != nil {
+		return nil, err
+	}
+
+	return s.Resolve(e), nil
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (e *internalEnumWrapper[T]) UnmarshalJSON(data []byte) error {
+	if name, ok := plainJSONString(data); ok {
+		ie, err := parseInternalEnumBytes[T](name)
+		if err != nil {
+			return err
+		}
+
+		e.internalEnum = ie
+
+		return nil
+	}
+
 	var name string
 	var err error
 
@@ -152,7 +484,7 @@ func (e *internalEnumWrapper[T]) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("source should be a string, got %s", data)
 	}
 
-	e.internalEnum, err = getInternalEnumForName[T](name)
+	e.internalEnum, err = parseInternalEnum[T](name)
 	if err != nil {
 		return err
 	}
@@ -166,34 +498,81 @@ func (e internalEnumWrapper[T]) MarshalText() ([]byte, error) {
 		return nil, fmt.Errorf("enum not initialized")
 	}
 
-	return []byte(e.Name()), nil
+	m, err := e.marshaled()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(m.encodedName()), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
 func (e *internalEnumWrapper[T]) UnmarshalText(text []byte) error {
-	name := string(text)
-
-	var err error
-	e.internalEnum, err = getInternalEnumForName[T](name)
+	ie, err := parseInternalEnumBytes[T](text)
 	if err != nil {
 		return err
 	}
 
+	e.internalEnum = ie
+
 	return nil
 }
 
+// plainJSONString returns the contents of data if it is a JSON string of
+// ASCII characters without escape sequences, which decodes to the contents
+// themselves.
+func plainJSONString(data []byte) ([]byte, bool) {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return nil, false
+	}
+
+	name := data[1 : len(data)-1]
+	for _, c := range name {
+		if c < 0x20 || c >= 0x80 || c == '"' || c == '\\' {
+			return nil, false
+		}
+	}
+
+	return name, true
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The
+// encoding is the same as the one used by MarshalText as names, unlike IDs,
+// do not depend on the registration order.
+func (e internalEnumWrapper[T]) MarshalBinary() ([]byte, error) {
+	return e.MarshalText()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (e *internalEnumWrapper[T]) UnmarshalBinary(data []byte) error {
+	return e.UnmarshalText(data)
+}
+
 // Value implements the driver.Valuer interface.
 func (e internalEnumWrapper[T]) Value() (driver.Value, error) {
 	if !e.Valid() {
 		return nil, fmt.Errorf("enum not initialized")
 	}
 
-	return e.Name(), nil
+	m, err := e.marshaled()
+	if err != nil {
+		return nil, err
+	}
+
+	return m.encodedName(), nil
 }
 
-// Scan implements the sql.Scanner interface.
+// Scan implements the sql.Scanner interface. NULL values are scanned as the
+// enum with ID 0 if RequireUnknownZero was called and leave the Enum
+// untouched otherwise.
 func (e *internalEnumWrapper[T]) Scan(value any) error {
 	if value == nil {
+		if s, err := getSetForType[T](); err == nil {
+			if u := s.Unknown(); u != nil {
+				e.internalEnum = u
+			}
+		}
+
 		return nil
 	}
 
@@ -208,7 +587,7 @@ func (e *internalEnumWrapper[T]) Scan(value any) error {
 	}
 
 	var err error
-	e.internalEnum, err = getInternalEnumForName[T](name)
+	e.internalEnum, err = parseInternalEnum[T](name)
 	if err != nil {
 		return err
 	}
@@ -218,11 +597,11 @@ func (e *internalEnumWrapper[T]) Scan(value any) error {
 
 // String implements the fmt.Stringer interface.
 func (e internalEnumWrapper[T]) String() string {
-	if !e.Valid() {
-		panic("enum not initialized")
+	if e.internalEnum == nil {
+		panicNotInitialized[T]()
 	}
 
-	return e.name
+	return e.internalEnum.name
 }
 
 // internalEnum is the internal representation of an Enum and is the type that
@@ -230,4 +609,31 @@ func (e internalEnumWrapper[T]) String() string {
 type internalEnum[T constraints.Integer] struct {
 	name string
 	id   T
+
+	// set is the set this enum belongs to.
+	set *internalSet[T]
+
+	// autoID is true if the ID was auto-generated based on the registration
+	// order.
+	autoID bool
+
+	// unrecognized is true for enums dynamically added to open types.
+	unrecognized bool
+
+	// unresolved is true for enums created by Declare until they are
+	// registered by Resolve.
+	unresolved bool
+
+	// updates holds the map[string]string of metadata set with
+	// UpdateMetadata.
+	updates atomic.Value
+
+	attributes
+}
+
+// markUsed marks the set this enum belongs to as used.
+func (e *internalEnum[T]) markUsed() {
+	if e.set != nil {
+		e.set.MarkUsed()
+	}
 }
@@ -3,6 +3,7 @@ package enum
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -199,3 +200,166 @@ func TestEnum_EnumsForType(t *testing.T) {
 		t.Errorf("expected 4, got %d", len(enums))
 	}
 }
+
+func TestEnum_Equal(t *testing.T) {
+	// Simulate what deep copy libraries do: same data, different pointer.
+	copied := RoleEnum{internalEnumWrapper[Role]{&internalEnum[Role]{
+		name: Admin.name,
+		id:   Admin.id,
+	}}}
+
+	if copied == Admin {
+		t.Fatalf("expected copied enum to have a different internal pointer")
+	}
+	if !copied.Equal(Admin) {
+		t.Errorf("expected %s to be equal to %s", copied, Admin)
+	}
+	if copied.Equal(User) {
+		t.Errorf("expected %s to not be equal to %s", copied, User)
+	}
+	if Admin.Equal(RoleEnum{}) {
+		t.Errorf("expected %s to not be equal to an invalid enum", Admin)
+	}
+	if !(RoleEnum{}).Equal(RoleEnum{}) {
+		t.Errorf("expected invalid enums to be equal")
+	}
+
+	canonical, err := Canonical(copied)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if canonical != Admin {
+		t.Errorf("expected internalEnum pointer %p, got %p", Admin.internalEnum, canonical.internalEnum)
+	}
+
+	if _, err := Canonical(RoleEnum{}); err == nil {
+		t.Errorf("expected error for invalid enum, got nil")
+	}
+}
+
+func TestLazy(t *testing.T) {
+	type lazyStatus int
+
+	// Declared before the enum is registered.
+	active := Lazy[lazyStatus]("Active")
+	missing := Lazy[lazyStatus]("Missing")
+
+	want := New[lazyStatus]("Active")
+
+	if got := active(); got != want {
+		t.Errorf("expected internalEnum pointer %p, got %p", want.internalEnum, got.internalEnum)
+	}
+	if got := active(); got != want {
+		t.Errorf("expected internalEnum pointer %p, got %p", want.internalEnum, got.internalEnum)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic, got normal execution")
+		}
+	}()
+
+	missing()
+}
+
+func TestPreallocate(t *testing.T) {
+	type preallocated int
+
+	New[preallocated]("Enum0")
+
+	Preallocate[preallocated](1000)
+
+	s, err := getSetForType[preallocated]()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cap(s.enums) < 1000 {
+		t.Errorf("expected capacity of at least 1000, got %d", cap(s.enums))
+	}
+
+	// Existing enums are kept.
+	if _, err := EnumByTypeAndName[preallocated]("Enum0"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func BenchmarkNew_Preallocated(b *testing.B) {
+	names := make([]string, 5000)
+	for i := range names {
+		names[i] = fmt.Sprintf("Enum%d", i)
+	}
+
+	for _, preallocate := range []bool{false, true} {
+		b.Run(fmt.Sprintf("preallocate=%t", preallocate), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				s := newInternalSet[int32]()
+				if preallocate {
+					s.Preallocate(len(names))
+				}
+
+				for _, name := range names {
+					s.Add(name, attributes{})
+				}
+			}
+		})
+	}
+}
+
+func TestEnum_EnumsForTypeCopy(t *testing.T) {
+	enums := EnumsByType[Role]()
+
+	enums[0] = Enum[Role](Guest)
+	enums = append(enums[:1], enums[2:]...)
+	_ = append(enums, Enum[Role](Admin))
+
+	again := EnumsByType[Role]()
+	if len(again) != 4 {
+		t.Fatalf("expected 4, got %d", len(again))
+	}
+
+	seen := make(map[Enum[Role]]bool)
+	for _, e := range again {
+		seen[e] = true
+	}
+	for _, e := range []RoleEnum{UnknownRole, Admin, User, Guest} {
+		if !seen[Enum[Role](e)] {
+			t.Errorf("expected %s to be returned, got %v", e, again)
+		}
+	}
+}
+
+func TestSet_ValuesCopy(t *testing.T) {
+	s := NewSet(Enum[Role](Admin), Enum[Role](User))
+
+	values := s.Values()
+	values[0] = Enum[Role](Guest)
+
+	if s.Contains(Guest) || !s.Contains(Admin) {
+		t.Errorf("expected set to be unaffected by changes to returned values")
+	}
+}
+
+func TestEnum_EnumsForTypeOrder(t *testing.T) {
+	type ordered int
+
+	names := make([]string, 100)
+	for i := range names {
+		names[i] = fmt.Sprintf("Enum%d", i)
+		New[ordered](names[i])
+	}
+
+	// Map iteration order would differ between calls.
+	for i := 0; i < 10; i++ {
+		for j, e := range EnumsByType[ordered]() {
+			if e.ID() != ordered(j) || e.Name() != names[j] {
+				t.Fatalf("expected %s (%d) at index %d, got %s (%d)", names[j], j, j, e.Name(), e.ID())
+			}
+		}
+	}
+
+	if got := Names[ordered](); !reflect.DeepEqual(got, names) {
+		t.Errorf("expected %v, got %v", names, got)
+	}
+}
@@ -0,0 +1,58 @@
+// Package enumavro integrates enums with Avro (through
+// github.com/hamba/avro). Enums implement encoding.TextMarshaler, so they can
+// be used directly as Avro enum fields once the schema is built with Schema.
+//
+// Writers may use schemas with symbols unknown to the reader (for example,
+// when a value is added before all consumers are updated). Fields of type
+// enum.OrUnknown[T] decode those symbols without failing and keep them as raw
+// names. Alternatively, Default sets the symbol readers resolving the writer
+// schema against their own should use instead.
+package enumavro
+
+import (
+	"fmt"
+
+	"github.com/bruno-ga/enum"
+	"github.com/hamba/avro/v2"
+	"golang.org/x/exp/constraints"
+)
+
+// Schema returns an Avro enum schema with the given name and namespace whose
+// symbols are the names of all enums associated with type T, in ID order. This
+// returns a non-nil error if the type has no enums or if any name is not a
+// valid Avro symbol.
+func Schema[T constraints.Integer](name, namespace string, opts ...avro.SchemaOption) (*avro.EnumSchema, error) {
+	enums := enum.EnumsByType[T]()
+	if len(enums) == 0 {
+		var t T
+		return nil, fmt.Errorf("no enums associated with type %T", t)
+	}
+
+	symbols := make([]string, 0, len(enums))
+	for _, e := range enums {
+		symbols = append(symbols, e.Name())
+	}
+
+	return avro.NewEnumSchema(name, namespace, symbols, opts...)
+}
+
+// MustSchema is like Schema but panics on error.
+func MustSchema[T constraints.Integer](name, namespace string, opts ...avro.SchemaOption) *avro.EnumSchema {
+	s, err := Schema[T](name, namespace, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// Default returns a schema option that sets the given value as the default
+// symbol of the schema, used by readers for symbols they do not know.
+func Default[E enum.EnumType[T], T constraints.Integer](value E) avro.SchemaOption {
+	e := enum.Enum[T](value)
+	if !e.Valid() {
+		panic("enum not initialized")
+	}
+
+	return avro.WithDefault(e.Name())
+}
@@ -0,0 +1,112 @@
+package enumavro
+
+import (
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"github.com/hamba/avro/v2"
+)
+
+type severity int
+
+type severityEnum enum.Enum[severity]
+
+var (
+	unknown = severityEnum(enum.New[severity]("UNKNOWN"))
+	info    = severityEnum(enum.New[severity]("INFO"))
+	_       = severityEnum(enum.New[severity]("CRITICAL"))
+)
+
+type event struct {
+	Severity severityEnum `avro:"severity"`
+}
+
+type lenientEvent struct {
+	Severity enum.OrUnknown[severity] `avro:"severity"`
+}
+
+func recordSchema(t *testing.T, s avro.Schema) avro.Schema {
+	t.Helper()
+
+	field, err := avro.NewField("severity", s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	record, err := avro.NewRecordSchema("Event", "test", []*avro.Field{field})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return record
+}
+
+func TestSchema(t *testing.T) {
+	s := MustSchema[severity]("Severity", "test", Default(unknown))
+
+	if symbols := s.Symbols(); len(symbols) != 3 || symbols[0] != "UNKNOWN" || symbols[2] != "CRITICAL" {
+		t.Errorf("unexpected symbols %v", symbols)
+	}
+
+	if s.Default() != "UNKNOWN" {
+		t.Errorf("expected default UNKNOWN, got %s", s.Default())
+	}
+
+	record := recordSchema(t, s)
+
+	data, err := avro.Marshal(record, event{Severity: info})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got event
+	if err := avro.Unmarshal(record, data, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Severity != info {
+		t.Errorf("expected %s, got %v", info, got.Severity)
+	}
+}
+
+func TestSchema_UnknownWriterSymbol(t *testing.T) {
+	writer, err := avro.NewEnumSchema("Severity", "test", []string{"UNKNOWN", "INFO", "CRITICAL", "FATAL"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	record := recordSchema(t, writer)
+
+	data, err := avro.Marshal(record, map[string]any{"severity": "FATAL"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var strict event
+	if err := avro.Unmarshal(record, data, &strict); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	var lenient lenientEvent
+	if err := avro.Unmarshal(record, data, &lenient); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if lenient.Severity.Known() || lenient.Severity.Raw() != "FATAL" {
+		t.Errorf("expected unknown FATAL, got %v", lenient.Severity)
+	}
+}
+
+func TestSchema_Invalid(t *testing.T) {
+	type invalid int
+
+	if _, err := Schema[invalid]("Invalid", "test"); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	enum.New[invalid]("not-a-symbol")
+
+	if _, err := Schema[invalid]("Invalid", "test"); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
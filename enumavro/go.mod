@@ -0,0 +1,21 @@
+module github.com/bruno-ga/enum/enumavro
+
+go 1.18
+
+require (
+	github.com/bruno-ga/enum v0.0.0
+	github.com/hamba/avro/v2 v2.4.0
+	golang.org/x/exp v0.0.0-20220518171630-0b5c67f07fdf
+)
+
+require (
+	github.com/json-iterator/go v1.1.12 // indirect
+	github.com/mitchellh/mapstructure v1.5.0 // indirect
+	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
+	github.com/modern-go/reflect2 v1.0.2 // indirect
+	golang.org/x/text v0.21.0 // indirect
+	golang.org/x/time v0.6.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/bruno-ga/enum => ../
@@ -0,0 +1,186 @@
+// Package enumcli integrates enums with urfave/cli (v2) applications through
+// a flag type that validates input and lists the allowed values in its usage
+// text.
+//
+// Only version 2 of urfave/cli is supported: the stable releases of version 3
+// require a newer Go version than the one supported by this module.
+package enumcli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bruno-ga/enum"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/exp/constraints"
+)
+
+// Flag is a cli.Flag for enums associated with type T.
+type Flag[T constraints.Integer] struct {
+	Name     string
+	Aliases  []string
+	Usage    string
+	EnvVars  []string
+	Category string
+	Required bool
+	Hidden   bool
+
+	// Value is the default value of the flag.
+	Value enum.Enum[T]
+
+	// Destination, if set, receives the flag value.
+	Destination *enum.Enum[T]
+
+	// Action, if set, is called with the flag value after parsing.
+	Action func(*cli.Context, enum.Enum[T]) error
+
+	value      *value[T]
+	hasBeenSet bool
+}
+
+// Apply implements the cli.Flag interface.
+func (f *Flag[T]) Apply(set *flag.FlagSet) error {
+	p := f.Destination
+	if p == nil {
+		p = new(enum.Enum[T])
+	}
+
+	*p = f.Value
+	f.value = &value[T]{p: p}
+
+	for _, name := range f.EnvVars {
+		s, ok := os.LookupEnv(name)
+		if !ok || s == "" {
+			continue
+		}
+
+		if err := f.value.Set(s); err != nil {
+			return fmt.Errorf("could not parse %q from environment variable %s as value for flag %s: %s", s, name, f.Name, err)
+		}
+
+		f.hasBeenSet = true
+
+		break
+	}
+
+	for _, name := range f.Names() {
+		set.Var(f.value, name, f.GetUsage())
+	}
+
+	return nil
+}
+
+// Get returns the flag value in the given context.
+func (f *Flag[T]) Get(ctx *cli.Context) enum.Enum[T] {
+	if v, ok := ctx.Generic(f.Name).(*value[T]); ok {
+		return *v.p
+	}
+
+	return f.Value
+}
+
+// Names implements the cli.Flag interface.
+func (f *Flag[T]) Names() []string {
+	return cli.FlagNames(f.Name, f.Aliases)
+}
+
+// IsSet implements the cli.Flag interface.
+func (f *Flag[T]) IsSet() bool {
+	return f.hasBeenSet || (f.value != nil && f.value.set)
+}
+
+// String implements the cli.Flag interface.
+func (f *Flag[T]) String() string {
+	return cli.FlagStringer(f)
+}
+
+// IsRequired implements the cli.RequiredFlag interface.
+func (f *Flag[T]) IsRequired() bool {
+	return f.Required
+}
+
+// IsVisible implements the cli.VisibleFlag interface.
+func (f *Flag[T]) IsVisible() bool {
+	return !f.Hidden
+}
+
+// GetCategory implements the cli.CategorizableFlag interface.
+func (f *Flag[T]) GetCategory() string {
+	return f.Category
+}
+
+// TakesValue implements the cli.DocGenerationFlag interface.
+func (f *Flag[T]) TakesValue() bool {
+	return true
+}
+
+// GetUsage implements the cli.DocGenerationFlag interface. It appends the
+// allowed values to the configured usage.
+func (f *Flag[T]) GetUsage() string {
+	choices := "one of: " + strings.Join(enum.Names[T](), ", ")
+	if f.Usage == "" {
+		return choices
+	}
+
+	return fmt.Sprintf("%s (%s)", f.Usage, choices)
+}
+
+// GetValue implements the cli.DocGenerationFlag interface.
+func (f *Flag[T]) GetValue() string {
+	if f.value != nil {
+		return f.value.String()
+	}
+
+	return f.GetDefaultText()
+}
+
+// GetDefaultText implements the cli.DocGenerationFlag interface.
+func (f *Flag[T]) GetDefaultText() string {
+	if !f.Value.Valid() {
+		return ""
+	}
+
+	return f.Value.Name()
+}
+
+// GetEnvVars implements the cli.DocGenerationFlag interface.
+func (f *Flag[T]) GetEnvVars() []string {
+	return f.EnvVars
+}
+
+// RunAction implements the cli.ActionableFlag interface.
+func (f *Flag[T]) RunAction(ctx *cli.Context) error {
+	if f.Action == nil {
+		return nil
+	}
+
+	return f.Action(ctx, f.Get(ctx))
+}
+
+// value adapts a pointer to an enum to the flag.Value interface.
+type value[T constraints.Integer] struct {
+	p   *enum.Enum[T]
+	set bool
+}
+
+func (v *value[T]) String() string {
+	if v == nil || v.p == nil || !v.p.Valid() {
+		return ""
+	}
+
+	return v.p.Name()
+}
+
+func (v *value[T]) Set(s string) error {
+	var e enum.Enum[T]
+	if err := e.UnmarshalText([]byte(s)); err != nil {
+		return fmt.Errorf("must be one of: %s", strings.Join(enum.Names[T](), ", "))
+	}
+
+	*v.p = e
+	v.set = true
+
+	return nil
+}
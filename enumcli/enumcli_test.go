@@ -0,0 +1,107 @@
+package enumcli
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"github.com/urfave/cli/v2"
+)
+
+type role int
+
+var (
+	admin = enum.New[role]("Admin")
+	user  = enum.New[role]("User")
+)
+
+// Make sure all optional interfaces are implemented.
+var (
+	_ cli.RequiredFlag      = (*Flag[role])(nil)
+	_ cli.DocGenerationFlag = (*Flag[role])(nil)
+	_ cli.CategorizableFlag = (*Flag[role])(nil)
+	_ cli.ActionableFlag    = (*Flag[role])(nil)
+)
+
+func run(t *testing.T, f *Flag[role], args ...string) (enum.Enum[role], error) {
+	t.Helper()
+
+	var got enum.Enum[role]
+
+	app := &cli.App{
+		Flags:     []cli.Flag{f},
+		Writer:    io.Discard,
+		ErrWriter: io.Discard,
+		Action: func(ctx *cli.Context) error {
+			got = f.Get(ctx)
+			return nil
+		},
+	}
+
+	return got, app.Run(append([]string{"test"}, args...))
+}
+
+func TestFlag(t *testing.T) {
+	var dst enum.Enum[role]
+
+	f := &Flag[role]{Name: "role", Value: admin, Destination: &dst}
+
+	got, err := run(t, f, "--role", "User")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != user {
+		t.Errorf("expected %s, got %s", user, got)
+	}
+	if dst != user {
+		t.Errorf("expected destination %s, got %s", user, dst)
+	}
+	if !f.IsSet() {
+		t.Errorf("expected flag to be set")
+	}
+}
+
+func TestFlag_Default(t *testing.T) {
+	got, err := run(t, &Flag[role]{Name: "role", Value: admin})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != admin {
+		t.Errorf("expected %s, got %s", admin, got)
+	}
+}
+
+func TestFlag_EnvVar(t *testing.T) {
+	t.Setenv("TEST_ROLE", "User")
+
+	f := &Flag[role]{Name: "role", Value: admin, EnvVars: []string{"TEST_ROLE"}}
+
+	got, err := run(t, f)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != user {
+		t.Errorf("expected %s, got %s", user, got)
+	}
+	if !f.IsSet() {
+		t.Errorf("expected flag to be set")
+	}
+}
+
+func TestFlag_Invalid(t *testing.T) {
+	if _, err := run(t, &Flag[role]{Name: "role"}, "--role", "Root"); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
+
+func TestFlag_Usage(t *testing.T) {
+	f := &Flag[role]{Name: "role", Usage: "role to use", Value: admin}
+
+	if !strings.Contains(f.String(), "role to use (one of: Admin, User)") {
+		t.Errorf("expected usage to list allowed values, got %q", f.String())
+	}
+	if !strings.Contains(f.String(), `(default: Admin)`) {
+		t.Errorf("expected usage to contain the default value, got %q", f.String())
+	}
+}
@@ -0,0 +1,20 @@
+module github.com/bruno-ga/enum/enumcli
+
+go 1.18
+
+require (
+	github.com/bruno-ga/enum v0.0.0
+	github.com/urfave/cli/v2 v2.27.5
+	golang.org/x/exp v0.0.0-20220518171630-0b5c67f07fdf
+)
+
+require (
+	github.com/cpuguy83/go-md2man/v2 v2.0.6 // indirect
+	github.com/russross/blackfriday/v2 v2.1.0 // indirect
+	github.com/xrash/smetrics v0.0.0-20240521201337-686a1a2994c1 // indirect
+	golang.org/x/text v0.21.0 // indirect
+	golang.org/x/time v0.6.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/bruno-ga/enum => ../
@@ -0,0 +1,81 @@
+// Package enumcobra integrates enums with cobra commands. Enum flags are
+// validated on input, show their allowed values in the usage text and have
+// shell completion for all names.
+package enumcobra
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/bruno-ga/enum"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/constraints"
+)
+
+// Var defines a flag with the given name and usage for the given command. The
+// flag value is stored in p, which is set to the given default value. Shell
+// completion for the flag lists the names of all enums associated with type
+// T.
+func Var[E enum.EnumType[T], T constraints.Integer](cmd *cobra.Command, p *E, name string, value E, usage string) {
+	VarP(cmd, p, name, "", value, usage)
+}
+
+// VarP is like Var but also accepts a shorthand letter for the flag.
+func VarP[E enum.EnumType[T], T constraints.Integer](cmd *cobra.Command, p *E, name, shorthand string, value E, usage string) {
+	*p = value
+
+	names := enum.Names[T]()
+
+	cmd.Flags().VarP(NewValue[E](p), name, shorthand,
+		fmt.Sprintf("%s (one of: %s)", usage, strings.Join(names, ", ")))
+
+	err := cmd.RegisterFlagCompletionFunc(name, func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return names, cobra.ShellCompDirectiveNoFileComp
+	})
+	if err != nil {
+		// This only happens if the flag does not exist or already has a
+		// completion function, which are both programming errors.
+		panic(err)
+	}
+}
+
+// Value adapts a pointer to an enum to the pflag.Value interface.
+type Value[E enum.EnumType[T], T constraints.Integer] struct {
+	p *E
+}
+
+// NewValue returns a new Value that stores parsed values in p.
+func NewValue[E enum.EnumType[T], T constraints.Integer](p *E) *Value[E, T] {
+	return &Value[E, T]{p}
+}
+
+// String implements the pflag.Value interface.
+func (v *Value[E, T]) String() string {
+	e := enum.Enum[T](*v.p)
+	if !e.Valid() {
+		return ""
+	}
+
+	return e.Name()
+}
+
+// Set implements the pflag.Value interface.
+func (v *Value[E, T]) Set(s string) error {
+	var e enum.Enum[T]
+	if err := e.UnmarshalText([]byte(s)); err != nil {
+		return fmt.Errorf("%w (must be one of: %s)", err, strings.Join(enum.Names[T](), ", "))
+	}
+
+	*v.p = E(e)
+
+	return nil
+}
+
+// Type implements the pflag.Value interface. It returns the name of the
+// associated type T.
+func (v *Value[E, T]) Type() string {
+	var t T
+
+	return reflect.TypeOf(t).Name()
+}
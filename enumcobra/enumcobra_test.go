@@ -0,0 +1,93 @@
+package enumcobra
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"github.com/spf13/cobra"
+)
+
+type role int
+type roleEnum enum.Enum[role]
+
+var (
+	admin = roleEnum(enum.New[role]("Admin"))
+	user  = roleEnum(enum.New[role]("User"))
+)
+
+func newCommand(p *roleEnum) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "test",
+		Run: func(*cobra.Command, []string) {},
+	}
+
+	Var(cmd, p, "role", admin, "role to use")
+
+	return cmd
+}
+
+func TestVar(t *testing.T) {
+	var r roleEnum
+
+	cmd := newCommand(&r)
+	if r != admin {
+		t.Errorf("expected default %s, got %s", admin, r)
+	}
+
+	f := cmd.Flags().Lookup("role")
+	if f.DefValue != "Admin" {
+		t.Errorf("expected default value Admin, got %s", f.DefValue)
+	}
+	if !strings.Contains(f.Usage, "Admin, User") {
+		t.Errorf("expected usage to list allowed values, got %q", f.Usage)
+	}
+
+	cmd.SetArgs([]string{"--role", "User"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if r != user {
+		t.Errorf("expected %s, got %s", user, r)
+	}
+}
+
+func TestVar_Invalid(t *testing.T) {
+	var r roleEnum
+
+	cmd := newCommand(&r)
+	cmd.SetArgs([]string{"--role", "Root"})
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	err := cmd.Execute()
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	for _, expected := range []string{"name Root could not be found", "must be one of: "} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected %q in error, got %v", expected, err)
+		}
+	}
+}
+
+func TestVar_Completion(t *testing.T) {
+	var r roleEnum
+
+	cmd := newCommand(&r)
+
+	complete, ok := cmd.GetFlagCompletionFunc("role")
+	if !ok {
+		t.Fatalf("expected completion function to be registered")
+	}
+
+	names, directive := complete(cmd, nil, "")
+	if !reflect.DeepEqual(names, []string{"Admin", "User"}) {
+		t.Errorf("expected [Admin User], got %v", names)
+	}
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("expected no file completion directive, got %d", directive)
+	}
+}
@@ -0,0 +1,19 @@
+module github.com/bruno-ga/enum/enumcobra
+
+go 1.18
+
+require (
+	github.com/bruno-ga/enum v0.0.0
+	github.com/spf13/cobra v1.10.1
+	golang.org/x/exp v0.0.0-20220518171630-0b5c67f07fdf
+)
+
+require (
+	github.com/inconshreveable/mousetrap v1.1.0 // indirect
+	github.com/spf13/pflag v1.0.9 // indirect
+	golang.org/x/text v0.21.0 // indirect
+	golang.org/x/time v0.6.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/bruno-ga/enum => ../
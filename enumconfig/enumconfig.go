@@ -0,0 +1,57 @@
+// Package enumconfig wires enum-aware decoding into configuration libraries
+// based on mapstructure, like viper and koanf, so enum-typed configuration
+// fields can be decoded without per-service decode hooks.
+//
+// Names, IDs, defaults and aliases are handled as described in
+// enum.MapstructureHook. Errors for invalid values always list the allowed
+// values.
+package enumconfig
+
+import (
+	"github.com/bruno-ga/enum"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+// DecodeHook returns the enum decode hook as a mapstructure.DecodeHookFunc.
+func DecodeHook(opts ...enum.HookOption) mapstructure.DecodeHookFunc {
+	return enum.MapstructureHook(opts...)
+}
+
+// ViperOption returns an option to be passed to viper.Unmarshal (and similar
+// viper methods) that adds enum decoding in front of the hooks viper already
+// uses:
+//
+//	err := v.Unmarshal(&cfg, enumconfig.ViperOption())
+func ViperOption(opts ...enum.HookOption) func(*mapstructure.DecoderConfig) {
+	return func(c *mapstructure.DecoderConfig) {
+		c.DecodeHook = compose(DecodeHook(opts...), c.DecodeHook)
+	}
+}
+
+// DecoderConfig returns a decoder configuration equivalent to the default one
+// used by koanf with enum decoding added. The given out is the decoding result
+// and it must be a pointer:
+//
+//	err := k.UnmarshalWithConf("", &cfg, koanf.UnmarshalConf{
+//		DecoderConfig: enumconfig.DecoderConfig(&cfg),
+//	})
+func DecoderConfig(out any, opts ...enum.HookOption) *mapstructure.DecoderConfig {
+	return &mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			DecodeHook(opts...),
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToSliceHookFunc(","),
+			mapstructure.TextUnmarshallerHookFunc(),
+		),
+		Result:           out,
+		WeaklyTypedInput: true,
+	}
+}
+
+func compose(hook mapstructure.DecodeHookFunc, existing mapstructure.DecodeHookFunc) mapstructure.DecodeHookFunc {
+	if existing == nil {
+		return hook
+	}
+
+	return mapstructure.ComposeDecodeHookFunc(hook, existing)
+}
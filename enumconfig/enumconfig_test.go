@@ -0,0 +1,93 @@
+package enumconfig
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+type level int
+type levelEnum enum.Enum[level]
+
+var (
+	debug = levelEnum(enum.New[level]("Debug"))
+	info  = levelEnum(enum.New[level]("Info"))
+	warn  = levelEnum(enum.New[level]("Warn"))
+)
+
+type config struct {
+	Level   levelEnum
+	Default levelEnum
+	Legacy  levelEnum
+}
+
+func decode(t *testing.T, input map[string]any, opts ...enum.HookOption) (config, error) {
+	t.Helper()
+
+	var cfg config
+
+	c := &mapstructure.DecoderConfig{Result: &cfg}
+	ViperOption(opts...)(c)
+
+	d, err := mapstructure.NewDecoder(c)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return cfg, d.Decode(input)
+}
+
+func TestViperOption(t *testing.T) {
+	cfg, err := decode(t, map[string]any{
+		"level":   "info",
+		"default": "",
+		"legacy":  "warning",
+	},
+		enum.HookLenient(),
+		enum.HookDefault(debug),
+		enum.HookAliases(map[string]levelEnum{"Warning": warn}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if cfg.Level != info {
+		t.Errorf("expected %s, got %s", info, cfg.Level)
+	}
+	if cfg.Default != debug {
+		t.Errorf("expected %s, got %s", debug, cfg.Default)
+	}
+	if cfg.Legacy != warn {
+		t.Errorf("expected %s, got %s", warn, cfg.Legacy)
+	}
+}
+
+func TestViperOption_Error(t *testing.T) {
+	_, err := decode(t, map[string]any{"level": "Trace"})
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "Debug, Info, Warn") {
+		t.Errorf("expected error to list allowed values, got %q", err)
+	}
+}
+
+func TestDecoderConfig(t *testing.T) {
+	var cfg config
+
+	d, err := mapstructure.NewDecoder(DecoderConfig(&cfg))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := d.Decode(map[string]any{"level": 2}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if cfg.Level != warn {
+		t.Errorf("expected %s, got %s", warn, cfg.Level)
+	}
+}
@@ -0,0 +1,248 @@
+// Package enumdoc serves enum definitions over HTTP for internal developer
+// portals, as JSON, an HTML table or CSV depending on the Accept header of
+// the request.
+package enumdoc
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bruno-ga/enum"
+)
+
+// Content types served by Handler, in order of preference when the request
+// accepts several with the same quality.
+const (
+	JSON = "application/json"
+	HTML = "text/html"
+	CSV  = "text/csv"
+)
+
+var contentTypes = []string{JSON, HTML, CSV}
+
+// Handler returns an http.Handler that serves the definitions of the enums of
+// the given types (or of all types with registered enums if none are given)
+// as returned by enum.Describe. Definitions are described on every request
+// so enums registered later are included.
+//
+// The format is negotiated with the Accept header and defaults to JSON if
+// there is none. Requests that do not accept any of the formats get a 406
+// (Not Acceptable) response and requests with methods other than GET and
+// HEAD a 405 (Method Not Allowed) response.
+func Handler(types ...enum.TypeSelector) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Add("Vary", "Accept")
+
+		contentType := negotiate(r.Header.Values("Accept"))
+		if contentType == "" {
+			http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+			return
+		}
+
+		descriptions := enum.Describe(types...)
+
+		w.Header().Set("Content-Type", contentType+"; charset=utf-8")
+
+		if r.Method == http.MethodHead {
+			return
+		}
+
+		switch contentType {
+		case JSON:
+			writeJSON(w, descriptions)
+		case HTML:
+			writeHTML(w, descriptions)
+		case CSV:
+			writeCSV(w, descriptions)
+		}
+	})
+}
+
+// negotiate returns the content type to respond with for the given Accept
+// header values, or "" if none of the supported ones is acceptable.
+func negotiate(accept []string) string {
+	if len(accept) == 0 {
+		return contentTypes[0]
+	}
+
+	best, bestQuality := "", 0.0
+
+	for _, contentType := range contentTypes {
+		quality, specificity := 0.0, -1
+
+		for _, header := range accept {
+			for _, part := range strings.Split(header, ",") {
+				mediaRange, q, s := parseMediaRange(part)
+				if s <= specificity || !matches(mediaRange, contentType) {
+					continue
+				}
+
+				quality, specificity = q, s
+			}
+		}
+
+		if quality > bestQuality {
+			best, bestQuality = contentType, quality
+		}
+	}
+
+	return best
+}
+
+// parseMediaRange parses an element of an Accept header and returns the media
+// range, its quality and its specificity (0 for */*, 1 for type/* and 2 for
+// type/subtype).
+func parseMediaRange(part string) (string, float64, int) {
+	params := strings.Split(part, ";")
+	mediaRange := strings.ToLower(strings.TrimSpace(params[0]))
+
+	quality := 1.0
+	for _, param := range params[1:] {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if strings.ToLower(key) != "q" {
+			continue
+		}
+
+		q, err := strconv.ParseFloat(value, 64)
+		if err != nil || q < 0 || q > 1 {
+			q = 0
+		}
+
+		quality = q
+	}
+
+	switch {
+	case mediaRange == "*/*":
+		return mediaRange, quality, 0
+	case strings.HasSuffix(mediaRange, "/*"):
+		return mediaRange, quality, 1
+	default:
+		return mediaRange, quality, 2
+	}
+}
+
+func matches(mediaRange, contentType string) bool {
+	if mediaRange == "*/*" || mediaRange == contentType {
+		return true
+	}
+
+	typ, _, _ := strings.Cut(contentType, "/")
+
+	return mediaRange == typ+"/*"
+}
+
+type jsonType struct {
+	Type   string      `json:"type"`
+	Values []jsonValue `json:"values"`
+}
+
+type jsonValue struct {
+	Name       string            `json:"name"`
+	ID         json.Number       `json:"id"`
+	Deprecated bool              `json:"deprecated,omitempty"`
+	Groups     []string          `json:"groups,omitempty"`
+	Tags       []string          `json:"tags,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, descriptions []enum.TypeDescription) {
+	types := make([]jsonType, 0, len(descriptions))
+	for _, d := range descriptions {
+		values := make([]jsonValue, 0, len(d.Values))
+		for _, v := range d.Values {
+			values = append(values, jsonValue{
+				Name:       v.Name,
+				ID:         json.Number(v.ID),
+				Deprecated: v.Deprecated,
+				Groups:     v.Groups,
+				Tags:       v.Tags,
+				Metadata:   v.Metadata,
+			})
+		}
+
+		types = append(types, jsonType{Type: d.Type.String(), Values: values})
+	}
+
+	_ = json.NewEncoder(w).Encode(types)
+}
+
+var htmlTemplate = template.Must(template.New("enums").Funcs(template.FuncMap{
+	"join":     func(values []string) string { return strings.Join(values, ", ") },
+	"metadata": formatMetadata,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Enums</title>
+</head>
+<body>
+{{- range .}}
+<h2>{{.Type}}</h2>
+<table>
+<thead>
+<tr><th>Name</th><th>ID</th><th>Deprecated</th><th>Groups</th><th>Tags</th><th>Metadata</th></tr>
+</thead>
+<tbody>
+{{- range .Values}}
+<tr><td>{{.Name}}</td><td>{{.ID}}</td><td>{{if .Deprecated}}yes{{end}}</td><td>{{join .Groups}}</td><td>{{join .Tags}}</td><td>{{metadata .Metadata}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+func writeHTML(w http.ResponseWriter, descriptions []enum.TypeDescription) {
+	_ = htmlTemplate.Execute(w, descriptions)
+}
+
+func writeCSV(w http.ResponseWriter, descriptions []enum.TypeDescription) {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"type", "name", "id", "deprecated", "groups", "tags", "metadata"})
+
+	for _, d := range descriptions {
+		for _, v := range d.Values {
+			_ = cw.Write([]string{
+				d.Type.String(),
+				v.Name,
+				v.ID,
+				strconv.FormatBool(v.Deprecated),
+				strings.Join(v.Groups, ";"),
+				strings.Join(v.Tags, ";"),
+				formatMetadata(v.Metadata),
+			})
+		}
+	}
+
+	cw.Flush()
+}
+
+// formatMetadata returns the metadata as key=value pairs sorted by key and
+// separated by semicolons.
+func formatMetadata(metadata map[string]string) string {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+metadata[key])
+	}
+
+	return strings.Join(pairs, ";")
+}
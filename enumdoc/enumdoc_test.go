@@ -0,0 +1,105 @@
+package enumdoc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+)
+
+type level int
+
+var (
+	_ = enum.New[level]("Low", enum.Metadata("label", "<low>"))
+	_ = enum.New[level]("High", enum.Deprecated(), enum.Group("a", "b"))
+)
+
+func serve(t *testing.T, method, accept string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	r := httptest.NewRequest(method, "/enums", nil)
+	if accept != "" {
+		r.Header.Set("Accept", accept)
+	}
+
+	w := httptest.NewRecorder()
+	Handler(enum.TypeOf[level]()).ServeHTTP(w, r)
+
+	return w
+}
+
+func TestHandler_JSON(t *testing.T) {
+	w := serve(t, http.MethodGet, "")
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/json; charset=utf-8" {
+		t.Errorf("unexpected content type %s", ct)
+	}
+
+	expected := `[{"type":"enumdoc.level","values":[{"name":"Low","id":0,"metadata":{"label":"\u003clow\u003e"}},{"name":"High","id":1,"deprecated":true,"groups":["a","b"]}]}]` + "\n"
+	if body := w.Body.String(); body != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+}
+
+func TestHandler_HTML(t *testing.T) {
+	w := serve(t, http.MethodGet, "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+
+	if ct := w.Header().Get("Content-Type"); ct != "text/html; charset=utf-8" {
+		t.Errorf("unexpected content type %s", ct)
+	}
+
+	body := w.Body.String()
+	for _, s := range []string{"<h2>enumdoc.level</h2>", "<td>High</td><td>1</td><td>yes</td><td>a, b</td>", "label=&lt;low&gt;"} {
+		if !strings.Contains(body, s) {
+			t.Errorf("expected %s in %s", s, body)
+		}
+	}
+}
+
+func TestHandler_CSV(t *testing.T) {
+	w := serve(t, http.MethodGet, "application/json;q=0.5, text/csv")
+
+	expected := "type,name,id,deprecated,groups,tags,metadata\n" +
+		"enumdoc.level,Low,0,false,,,label=<low>\n" +
+		"enumdoc.level,High,1,true,a;b,,\n"
+	if body := w.Body.String(); body != expected {
+		t.Errorf("expected %q, got %q", expected, body)
+	}
+}
+
+func TestHandler_NotAcceptable(t *testing.T) {
+	if w := serve(t, http.MethodGet, "image/png, text/*;q=0"); w.Code != http.StatusNotAcceptable {
+		t.Errorf("expected status %d, got %d", http.StatusNotAcceptable, w.Code)
+	}
+}
+
+func TestHandler_Method(t *testing.T) {
+	if w := serve(t, http.MethodPost, ""); w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+
+	if w := serve(t, http.MethodHead, ""); w.Code != http.StatusOK || w.Body.Len() != 0 {
+		t.Errorf("unexpected response %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		accept   string
+		expected string
+	}{
+		{"*/*", JSON},
+		{"text/*", HTML},
+		{"text/*, text/html;q=0.1", CSV},
+		{"application/json;q=0", ""},
+		{"Text/CSV", CSV},
+	}
+
+	for _, test := range tests {
+		if contentType := negotiate([]string{test.accept}); contentType != test.expected {
+			t.Errorf("expected %q for %s, got %q", test.expected, test.accept, contentType)
+		}
+	}
+}
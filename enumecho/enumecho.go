@@ -0,0 +1,84 @@
+// Package enumecho integrates enums with Echo. Invalid enum values in request
+// bodies and parameters are reported as structured 422 (Unprocessable Entity)
+// responses listing the allowed values.
+//
+// Enums bind from query, form and path parameters with c.Bind through their
+// UnmarshalParam method. Binder also decodes JSON bodies with
+// enum.DecodeJSON, so all invalid values are reported at once.
+package enumecho
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bruno-ga/enum"
+	"github.com/labstack/echo/v4"
+)
+
+// ErrorHandler returns an echo.HTTPErrorHandler writing an enum.InvalidValues
+// response with status 422 (Unprocessable Entity) for errors describing
+// invalid enum values (see enum.NewInvalidValues) and calling next for other
+// errors:
+//
+//	e := echo.New()
+//	e.Binder = &enumecho.Binder{}
+//	e.HTTPErrorHandler = enumecho.ErrorHandler(e.DefaultHTTPErrorHandler)
+//
+// The field of values bound from request parameters is the name of the
+// parameter, if known.
+func ErrorHandler(next echo.HTTPErrorHandler) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		v, ok := enum.NewInvalidValues(err)
+		if !ok || c.Response().Committed {
+			next(err, c)
+			return
+		}
+
+		var be *echo.BindingError
+		if errors.As(err, &be) && len(v.Fields) == 1 && v.Fields[0].Field == "" {
+			v.Fields[0].Field = be.Field
+		}
+
+		if err := c.JSON(http.StatusUnprocessableEntity, v); err != nil {
+			c.Logger().Error(err)
+		}
+	}
+}
+
+// Binder is an echo.Binder that binds requests like echo.DefaultBinder, except
+// that JSON bodies are decoded with enum.DecodeJSON, so the returned error
+// wraps an enum.FieldErrors with all the invalid enum values of the body if
+// there are any.
+type Binder struct {
+	echo.DefaultBinder
+}
+
+// Bind implements the echo.Binder interface.
+func (b *Binder) Bind(i any, c echo.Context) error {
+	req := c.Request()
+	if req.ContentLength == 0 || !strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		return b.DefaultBinder.Bind(i, c)
+	}
+
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	// Other errors (like syntax errors) are reported by the default binder.
+	if err := enum.DecodeJSON(data, i); err != nil {
+		var errs enum.FieldErrors
+		if errors.As(err, &errs) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+		}
+	}
+
+	// The default binder binds the parameters and decodes the body again,
+	// now that its enum values are known to be valid.
+	req.Body = io.NopCloser(bytes.NewReader(data))
+
+	return b.DefaultBinder.Bind(i, c)
+}
@@ -0,0 +1,118 @@
+package enumecho
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"github.com/labstack/echo/v4"
+)
+
+type role int
+type roleEnum = enum.Enum[role]
+
+var (
+	admin = enum.New[role]("Admin")
+	user  = enum.New[role]("User")
+)
+
+type request struct {
+	Role  roleEnum   `json:"role" query:"role"`
+	Roles []roleEnum `json:"roles"`
+}
+
+func newServer(got *request) *echo.Echo {
+	e := echo.New()
+	e.Binder = &Binder{}
+	e.HTTPErrorHandler = ErrorHandler(e.DefaultHTTPErrorHandler)
+
+	handler := func(c echo.Context) error {
+		if err := c.Bind(got); err != nil {
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+
+	e.POST("/users", handler)
+	e.GET("/users", handler)
+	e.GET("/fail", func(echo.Context) error {
+		return errors.New("other")
+	})
+
+	return e
+}
+
+func serve(e *echo.Echo, method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestBinder(t *testing.T) {
+	var got request
+
+	if w := serve(newServer(&got), http.MethodPost, "/users", `{"role": "Admin", "roles": ["User"]}`); w.Code != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d: %s", w.Code, w.Body)
+	}
+
+	if got.Role != admin || len(got.Roles) != 1 || got.Roles[0] != user {
+		t.Errorf("unexpected request %+v", got)
+	}
+
+	got = request{}
+
+	if w := serve(newServer(&got), http.MethodGet, "/users?role=User", ""); w.Code != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d: %s", w.Code, w.Body)
+	}
+
+	if got.Role != user {
+		t.Errorf("expected %s, got %s", user, got.Role)
+	}
+
+	if w := serve(newServer(&request{}), http.MethodPost, "/users", `{"role": `); w.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400 for invalid JSON, got %d", w.Code)
+	}
+}
+
+func TestErrorHandler(t *testing.T) {
+	tests := []struct {
+		method, target, body string
+		expected             string
+	}{
+		{
+			http.MethodPost, "/users", `{"role": "Root", "roles": ["User", "Guest"]}`,
+			`{"message":"invalid enum values","fields":[` +
+				`{"field":"/role","value":"\"Root\"","allowed":["Admin","User"]},` +
+				`{"field":"/roles/1","value":"\"Guest\"","allowed":["Admin","User"]}]}`,
+		},
+		{
+			http.MethodGet, "/users?role=Root", "",
+			`{"message":"invalid enum values","fields":[{"field":"","value":"Root","allowed":["Admin","User"]}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		w := serve(newServer(&request{}), test.method, test.target, test.body)
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected status 422, got %d", w.Code)
+		}
+
+		if body := strings.TrimSpace(w.Body.String()); body != test.expected {
+			t.Errorf("expected body %s, got %s", test.expected, body)
+		}
+	}
+
+	if w := serve(newServer(&request{}), http.MethodGet, "/fail", ""); w.Code != http.StatusInternalServerError {
+		t.Errorf("expected status 500 for other errors, got %d", w.Code)
+	}
+}
@@ -0,0 +1,77 @@
+// Package enumgin integrates enums with Gin. Invalid enum values in request
+// bodies and parameters are reported as structured 422 (Unprocessable Entity)
+// responses listing the allowed values.
+//
+// Enums bind from query, form and path parameters with c.ShouldBind and
+// c.ShouldBindQuery through their UnmarshalParam method, which requires Gin
+// 1.10 or later. JSON bodies should be bound with ShouldBindJSON, so all
+// invalid values are reported at once.
+package enumgin
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/bruno-ga/enum"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// Errors returns a middleware writing an enum.InvalidValues response with
+// status 422 (Unprocessable Entity) if a handler added an error describing
+// invalid enum values (see enum.NewInvalidValues) to the context with c.Error
+// and did not write a response:
+//
+//	r.Use(enumgin.Errors())
+//
+//	r.POST("/users", func(c *gin.Context) {
+//		var req CreateUserRequest
+//		if err := enumgin.ShouldBindJSON(c, &req); err != nil {
+//			_ = c.Error(err)
+//			return
+//		}
+//		...
+//	})
+//
+// Other errors are left for other middlewares to handle.
+func Errors() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if c.Writer.Written() {
+			return
+		}
+
+		for _, err := range c.Errors {
+			if v, ok := enum.NewInvalidValues(err.Err); ok {
+				c.AbortWithStatusJSON(http.StatusUnprocessableEntity, v)
+				return
+			}
+		}
+	}
+}
+
+// ShouldBindJSON is like c.ShouldBindJSON but decodes the body with
+// enum.DecodeJSON, so it returns an enum.FieldErrors with all the invalid enum
+// values of the body if there are any. The decoded value is then validated
+// with binding.Validator, as with c.ShouldBindJSON.
+func ShouldBindJSON(c *gin.Context, obj any) error {
+	if c.Request == nil || c.Request.Body == nil {
+		return binding.JSON.Bind(c.Request, obj)
+	}
+
+	data, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return err
+	}
+
+	if err := enum.DecodeJSON(data, obj); err != nil {
+		return err
+	}
+
+	if binding.Validator == nil {
+		return nil
+	}
+
+	return binding.Validator.ValidateStruct(obj)
+}
@@ -0,0 +1,120 @@
+package enumgin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"github.com/gin-gonic/gin"
+)
+
+type role int
+type roleEnum = enum.Enum[role]
+
+var (
+	admin = enum.New[role]("Admin")
+	user  = enum.New[role]("User")
+)
+
+type request struct {
+	Name  string     `json:"name" binding:"required"`
+	Role  roleEnum   `json:"role"`
+	Roles []roleEnum `json:"roles"`
+}
+
+func newRouter(got *request) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.Use(Errors())
+
+	r.POST("/users", func(c *gin.Context) {
+		if err := ShouldBindJSON(c, got); err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	})
+
+	r.GET("/users", func(c *gin.Context) {
+		var r roleEnum
+		if err := r.UnmarshalParam(c.Query("role")); err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	})
+
+	r.GET("/fail", func(c *gin.Context) {
+		_ = c.Error(errors.New("other"))
+	})
+
+	return r
+}
+
+func serve(r *gin.Engine, method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestShouldBindJSON(t *testing.T) {
+	var got request
+
+	w := serve(newRouter(&got), http.MethodPost, "/users", `{"name": "Ada", "role": "Admin", "roles": ["User"]}`)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d: %s", w.Code, w.Body)
+	}
+
+	if got.Role != admin || len(got.Roles) != 1 || got.Roles[0] != user {
+		t.Errorf("unexpected request %+v", got)
+	}
+
+	// Validation still applies.
+	if w := serve(newRouter(&request{}), http.MethodPost, "/users", `{"role": "User"}`); w.Code == http.StatusNoContent {
+		t.Errorf("expected a validation error, got status %d", w.Code)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		method, target, body string
+		expected             string
+	}{
+		{
+			http.MethodPost, "/users", `{"role": "Root", "roles": ["User", "Guest"]}`,
+			`{"message":"invalid enum values","fields":[` +
+				`{"field":"/role","value":"\"Root\"","allowed":["Admin","User"]},` +
+				`{"field":"/roles/1","value":"\"Guest\"","allowed":["Admin","User"]}]}`,
+		},
+		{
+			http.MethodGet, "/users?role=Root", "",
+			`{"message":"invalid enum values","fields":[{"field":"","value":"Root","allowed":["Admin","User"]}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		w := serve(newRouter(&request{}), test.method, test.target, test.body)
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected status 422, got %d", w.Code)
+		}
+
+		if body := w.Body.String(); body != test.expected {
+			t.Errorf("expected body %s, got %s", test.expected, body)
+		}
+	}
+
+	// Other errors are not handled.
+	if w := serve(newRouter(&request{}), http.MethodGet, "/fail", ""); w.Code == http.StatusUnprocessableEntity {
+		t.Errorf("unexpected status 422 for other errors")
+	}
+}
@@ -0,0 +1,121 @@
+// Package enumkong integrates enums with kong command-line parsers. Enum
+// fields of kong CLI structs are validated on input and show their allowed
+// values in the help text.
+package enumkong
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/alecthomas/kong"
+	"github.com/bruno-ga/enum"
+	"golang.org/x/exp/constraints"
+)
+
+// ChoicesVar is the name of the variable holding the names of the enums of a
+// field, separated by commas, which can be interpolated in its help text
+// (as in help:"Role to use (${choices})").
+const ChoicesVar = "choices"
+
+// Register returns a kong option that makes flags and positional arguments
+// of type E or *E be parsed by the Mapper of E (kong also uses it for the
+// elements of slices of E, but their help texts do not get the allowed
+// values):
+//
+//	var cli struct {
+//		Role  RoleEnum   `help:"Role to use." default:"Admin"`
+//		Roles []RoleEnum `help:"Roles to grant."`
+//	}
+//
+//	kong.Parse(&cli,
+//		enumkong.Register[RoleEnum](),
+//		kong.ValueFormatter(enumkong.HelpValueFormatter(kong.DefaultHelpValueFormatter)),
+//	)
+//
+// Fields of type enum.Enum[T] are decoded by kong with their UnmarshalText
+// method even if the type is not registered, but only registered types list
+// their allowed values in help texts and errors.
+func Register[E enum.EnumType[T], T constraints.Integer]() kong.Option {
+	return kong.OptionFunc(func(k *kong.Kong) error {
+		t := reflect.TypeOf((*E)(nil))
+
+		if err := kong.TypeMapper(t.Elem(), Mapper[E]()).Apply(k); err != nil {
+			return err
+		}
+
+		return kong.TypeMapper(t, Mapper[E]()).Apply(k)
+	})
+}
+
+// Mapper returns a kong.Mapper for enums of type E (and pointers to them, which
+// are allocated if nil when a value is decoded). It can also be used for
+// individual fields with kong.NamedMapper and the type tag. The mapper
+// contributes ChoicesVar to the variables interpolated in the help text.
+func Mapper[E enum.EnumType[T], T constraints.Integer]() kong.Mapper {
+	return mapper[E, T]{}
+}
+
+// mapper implements kong.Mapper and kong.VarsContributor for enums of type
+// E.
+type mapper[E enum.EnumType[T], T constraints.Integer] struct{}
+
+// Decode implements the kong.Mapper interface.
+func (mapper[E, T]) Decode(ctx *kong.DecodeContext, target reflect.Value) error {
+	var s string
+	if err := ctx.Scan.PopValueInto("value", &s); err != nil {
+		return err
+	}
+
+	var e enum.Enum[T]
+	if err := e.UnmarshalText([]byte(s)); err != nil {
+		return fmt.Errorf("%w (must be one of: %s)", err, strings.Join(enum.Names[T](), ", "))
+	}
+
+	if target.Kind() == reflect.Pointer {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+
+		target = target.Elem()
+	}
+
+	target.Set(reflect.ValueOf(E(e)))
+
+	return nil
+}
+
+// Vars implements the kong.VarsContributor interface.
+func (mapper[E, T]) Vars(*kong.Value) kong.Vars {
+	return kong.Vars{ChoicesVar: strings.Join(enum.Names[T](), ",")}
+}
+
+// choices returns the names of the enums decoded by the mapper.
+func (mapper[E, T]) choices() []string {
+	return enum.Names[T]()
+}
+
+// HelpValueFormatter returns a kong.HelpValueFormatter that formats help texts
+// with next and appends the allowed values to the help texts of enum fields
+// parsed by a Mapper, unless they already interpolate ChoicesVar.
+func HelpValueFormatter(next kong.HelpValueFormatter) kong.HelpValueFormatter {
+	return func(value *kong.Value) string {
+		help := next(value)
+
+		m, ok := value.Mapper.(interface{ choices() []string })
+		if !ok || kong.HasInterpolatedVar(value.OrigHelp, ChoicesVar) {
+			return help
+		}
+
+		suffix := "(one of: " + strings.Join(m.choices(), ", ") + ")"
+
+		switch {
+		case strings.HasSuffix(help, "."):
+			return help[:len(help)-1] + " " + suffix + "."
+		case help == "":
+			return suffix
+		default:
+			return help + " " + suffix
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package enumkong
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/kong"
+	"github.com/bruno-ga/enum"
+)
+
+type role int
+type roleEnum enum.Enum[role]
+
+var (
+	admin = roleEnum(enum.New[role]("Admin"))
+	user  = roleEnum(enum.New[role]("User"))
+	guest = roleEnum(enum.New[role]("Guest"))
+)
+
+type cli struct {
+	Role  roleEnum   `help:"Role to use." default:"Admin"`
+	Roles []roleEnum `help:"Roles to grant."`
+	Owner *roleEnum  `help:"Owner role (${choices})."`
+	Raw   enum.Enum[role]
+
+	Cmd struct {
+		Target roleEnum `arg:""`
+	} `cmd:""`
+}
+
+func newParser(t *testing.T, c *cli, out *bytes.Buffer) *kong.Kong {
+	t.Helper()
+
+	parser, err := kong.New(c,
+		Register[roleEnum](),
+		kong.ValueFormatter(HelpValueFormatter(kong.DefaultHelpValueFormatter)),
+		kong.Writers(out, out),
+		kong.Exit(func(int) {}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return parser
+}
+
+func TestRegister(t *testing.T) {
+	var c cli
+
+	parser := newParser(t, &c, &bytes.Buffer{})
+
+	if _, err := parser.Parse([]string{"--roles", "User,Guest", "--owner", "Guest", "--raw", "User", "cmd", "User"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if c.Role != admin {
+		t.Errorf("expected default %s, got %s", admin, c.Role)
+	}
+
+	if len(c.Roles) != 2 || c.Roles[0] != user || c.Roles[1] != guest {
+		t.Errorf("expected [User Guest], got %v", c.Roles)
+	}
+
+	if c.Owner == nil || *c.Owner != guest {
+		t.Errorf("expected owner %s, got %v", guest, c.Owner)
+	}
+
+	if c.Raw != enum.Enum[role](user) || c.Cmd.Target != user {
+		t.Errorf("expected %s, got %s and %s", user, c.Raw, c.Cmd.Target)
+	}
+}
+
+func TestRegister_Invalid(t *testing.T) {
+	for _, args := range [][]string{
+		{"--role", "Root", "cmd", "User"},
+		{"--roles", "User,Root", "cmd", "User"},
+		{"cmd", "Root"},
+	} {
+		var c cli
+
+		_, err := newParser(t, &c, &bytes.Buffer{}).Parse(args)
+		if err == nil || !strings.Contains(err.Error(), "must be one of: Admin, User, Guest") {
+			t.Errorf("expected error listing allowed values for %v, got %v", args, err)
+		}
+
+		if err != nil && !strings.Contains(err.Error(), "name Root could not be found") {
+			t.Errorf("expected the decoding error for %v, got %v", args, err)
+		}
+	}
+}
+
+func TestHelpValueFormatter(t *testing.T) {
+	var (
+		c   cli
+		out bytes.Buffer
+	)
+
+	parser := newParser(t, &c, &out)
+
+	// Parsing fails as the command is missing after printing the help.
+	_, _ = parser.Parse([]string{"--help"})
+
+	help := out.String()
+	for _, expected := range []string{
+		"Role to use (one of: Admin, User, Guest).",
+		"Owner role (Admin,User,Guest).",
+	} {
+		if !strings.Contains(help, expected) {
+			t.Errorf("expected help to contain %q, got:\n%s", expected, help)
+		}
+	}
+}
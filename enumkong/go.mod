@@ -0,0 +1,17 @@
+module github.com/bruno-ga/enum/enumkong
+
+go 1.18
+
+require (
+	github.com/alecthomas/kong v1.3.0
+	github.com/bruno-ga/enum v0.0.0
+	golang.org/x/exp v0.0.0-20220518171630-0b5c67f07fdf
+)
+
+require (
+	golang.org/x/text v0.21.0 // indirect
+	golang.org/x/time v0.6.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/bruno-ga/enum => ../
@@ -0,0 +1,74 @@
+// Package enumotel records enums as OpenTelemetry attributes with stable
+// semantics, so traces from different services use the same keys and values
+// for the same enums instead of whatever String returns at each call site.
+//
+// An enum is recorded as its name under the given key and, optionally, as its
+// ID under the key with an ".id" suffix:
+//
+//	span.SetAttributes(enumotel.Attributes("order.status", order.Status, enumotel.WithID())...)
+//	// order.status="Shipped", order.status.id=3
+package enumotel
+
+import (
+	"github.com/bruno-ga/enum"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/constraints"
+)
+
+// IDSuffix is appended to keys for the attribute holding the ID of an enum.
+const IDSuffix = ".id"
+
+// Option configures the attributes returned by Attributes.
+type Option func(*options)
+
+type options struct {
+	id bool
+}
+
+// WithID also records the ID of the enum, under the key with IDSuffix
+// appended. IDs are recorded as int64 values (OpenTelemetry has no unsigned
+// integers) and IDs of unrecognized enums (see enum.Open) are not recorded.
+func WithID() Option {
+	return func(o *options) {
+		o.id = true
+	}
+}
+
+// Attribute returns an attribute holding the name of the given value under
+// the given key. Uninitialized values are recorded as an empty string so
+// tracing code never panics.
+func Attribute[E enum.EnumType[T], T constraints.Integer](key string, value E) attribute.KeyValue {
+	e := enum.Enum[T](value)
+	if !e.Valid() {
+		return attribute.String(key, "")
+	}
+
+	return attribute.String(key, e.Name())
+}
+
+// Attributes returns the attributes recording the given value under the
+// given key: the one returned by Attribute followed by the ID if WithID is
+// given.
+func Attributes[E enum.EnumType[T], T constraints.Integer](key string, value E, opts ...Option) []attribute.KeyValue {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	attrs := []attribute.KeyValue{Attribute(key, value)}
+
+	if e := enum.Enum[T](value); o.id && e.Valid() && !e.Unrecognized() {
+		attrs = append(attrs, attribute.Int64(key+IDSuffix, int64(e.ID())))
+	}
+
+	return attrs
+}
+
+// AddEvent adds an event with the given name to the span recording the given
+// value (see Attributes), for example to trace state transitions:
+//
+//	enumotel.AddEvent(span, "order.transitioned", "order.status", next)
+func AddEvent[E enum.EnumType[T], T constraints.Integer](span trace.Span, name, key string, value E, opts ...Option) {
+	span.AddEvent(name, trace.WithAttributes(Attributes(key, value, opts...)...))
+}
@@ -0,0 +1,69 @@
+package enumotel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type status int
+
+var (
+	pending = enum.New[status]("Pending")
+	shipped = enum.New[status]("Shipped")
+)
+
+type statusEnum enum.Enum[status]
+
+func TestAttribute(t *testing.T) {
+	if attr := Attribute("order.status", shipped); attr != attribute.String("order.status", "Shipped") {
+		t.Errorf("unexpected attribute %v", attr)
+	}
+
+	if attr := Attribute("order.status", statusEnum(pending)); attr != attribute.String("order.status", "Pending") {
+		t.Errorf("unexpected attribute %v", attr)
+	}
+
+	if attr := Attribute("order.status", enum.Enum[status]{}); attr != attribute.String("order.status", "") {
+		t.Errorf("unexpected attribute %v", attr)
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	expected := []attribute.KeyValue{attribute.String("order.status", "Shipped")}
+	if attrs := Attributes("order.status", shipped); !reflect.DeepEqual(attrs, expected) {
+		t.Errorf("expected %v, got %v", expected, attrs)
+	}
+
+	expected = append(expected, attribute.Int64("order.status.id", 1))
+	if attrs := Attributes("order.status", shipped, WithID()); !reflect.DeepEqual(attrs, expected) {
+		t.Errorf("expected %v, got %v", expected, attrs)
+	}
+}
+
+type recordingSpan struct {
+	trace.Span
+
+	name  string
+	attrs []attribute.KeyValue
+}
+
+func (s *recordingSpan) AddEvent(name string, opts ...trace.EventOption) {
+	config := trace.NewEventConfig(opts...)
+
+	s.name = name
+	s.attrs = config.Attributes()
+}
+
+func TestAddEvent(t *testing.T) {
+	span := &recordingSpan{}
+	AddEvent(span, "order.transitioned", "order.status", pending, WithID())
+
+	expected := []attribute.KeyValue{attribute.String("order.status", "Pending"), attribute.Int64("order.status.id", 0)}
+	if span.name != "order.transitioned" || !reflect.DeepEqual(span.attrs, expected) {
+		t.Errorf("unexpected event %s %v", span.name, span.attrs)
+	}
+}
@@ -0,0 +1,43 @@
+// Package enumprom exposes enum.Counter values as Prometheus metrics.
+package enumprom
+
+import (
+	"github.com/bruno-ga/enum"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/exp/constraints"
+)
+
+// OtherValue is the label value used for increments reported by
+// enum.Counter.Other.
+const OtherValue = "other"
+
+// Collector is a prometheus.Collector that reports the counts of an
+// enum.Counter as a counter metric with one label holding the enum name.
+type Collector[T constraints.Integer] struct {
+	counter *enum.Counter[T]
+	desc    *prometheus.Desc
+}
+
+// NewCollector returns a new Collector for the given counter. The metric has
+// the given fully-qualified name and help text and the enum names are stored
+// in the given label.
+func NewCollector[T constraints.Integer](counter *enum.Counter[T], name, help, label string) *Collector[T] {
+	return &Collector[T]{
+		counter: counter,
+		desc:    prometheus.NewDesc(name, help, []string{label}, nil),
+	}
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *Collector[T]) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *Collector[T]) Collect(ch chan<- prometheus.Metric) {
+	for e, count := range c.counter.Snapshot() {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.CounterValue, float64(count), e.Name())
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.desc, prometheus.CounterValue, float64(c.counter.Other()), OtherValue)
+}
@@ -0,0 +1,41 @@
+package enumprom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type kind int
+
+var (
+	read  = enum.New[kind]("Read")
+	write = enum.New[kind]("Write")
+)
+
+func TestCollector(t *testing.T) {
+	counter := enum.NewCounter[kind]()
+	counter.Inc(read)
+	counter.Add(write, 3)
+
+	c := NewCollector(counter, "requests_total", "Requests by kind.", "kind")
+
+	reg := prometheus.NewPedanticRegistry()
+	if err := reg.Register(c); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `
+# HELP requests_total Requests by kind.
+# TYPE requests_total counter
+requests_total{kind="Read"} 1
+requests_total{kind="Write"} 3
+requests_total{kind="other"} 0
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "requests_total"); err != nil {
+		t.Error(err)
+	}
+}
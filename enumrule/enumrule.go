@@ -0,0 +1,466 @@
+// Package enumrule evaluates simple boolean expressions over enum values, so
+// policies can be defined in configuration instead of code:
+//
+//	rule, err := enumrule.Compile(`role in [Admin, User] && permission != Write`)
+//	...
+//	ok, err := rule.Eval(map[string]enum.Value{"role": role, "permission": permission})
+//
+// Expressions compare variables to enum names with ==, != and in (or not in)
+// lists, which can be combined with &&, ||, ! and parentheses. Names can be
+// qualified by their type name ("Role.Admin") and names that are not plain
+// identifiers can be quoted ("billing:read").
+//
+// Rules compiled with CompileVars check that the names they reference are
+// registered for the type of their variables, so a misspelled name is an
+// error instead of a comparison that never matches:
+//
+//	rule, err := enumrule.CompileVars(`role in [Admin, User] && permission != Write`,
+//		enumrule.Var[Role]("role"),
+//		enumrule.Var[Permission]("permission"),
+//	)
+package enumrule
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bruno-ga/enum"
+	"golang.org/x/exp/constraints"
+)
+
+// Variable declares a variable of a rule and the type of the enums it holds.
+// It is returned by Var.
+type Variable struct {
+	name     string
+	typeName string
+
+	// names are the names (plain and qualified) that can be compared with
+	// the variable.
+	names map[string]bool
+
+	// is returns true if the given value is associated with the type of the
+	// variable.
+	is func(enum.Value) bool
+}
+
+// Var returns the declaration of a variable with the given name holding enums
+// associated with type T. The names compared with the variable must be the
+// names of enums returned by EnumsByType (qualified or not) when the rule is
+// compiled.
+func Var[T constraints.Integer](name string) Variable {
+	v := Variable{
+		name:     name,
+		typeName: reflect.TypeOf(T(0)).Name(),
+		names:    make(map[string]bool),
+		is: func(value enum.Value) bool {
+			_, ok := value.(interface{ ID() T })
+			return ok
+		},
+	}
+
+	for _, e := range enum.EnumsByType[T]() {
+		v.names[e.Name()] = true
+		v.names[e.QualifiedName()] = true
+	}
+
+	return v
+}
+
+// Rule is a compiled expression.
+type Rule struct {
+	expr string
+	root node
+}
+
+// Compile parses the given expression. The names in the expression are not
+// checked (a misspelled name never matches) and variables can hold enums of
+// any type, so rules from untrusted or hand-edited sources (like
+// authorization policies) should be compiled with CompileVars instead.
+func Compile(expr string) (*Rule, error) {
+	return compile(expr, nil)
+}
+
+// CompileVars is like Compile but the expression can only reference the given
+// variables and compare them with names of enums of their types. Evaluating
+// the rule returns a non-nil error if a variable holds an enum of another
+// type.
+func CompileVars(expr string, vars ...Variable) (*Rule, error) {
+	declared := make(map[string]*Variable, len(vars))
+	for i := range vars {
+		declared[vars[i].name] = &vars[i]
+	}
+
+	return compile(expr, declared)
+}
+
+// compile parses the given expression, checking its variables and names
+// against the given variables unless vars is nil.
+func compile(expr string, vars map[string]*Variable) (*Rule, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, vars: vars}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %s at offset %d", p.peek().text, p.peek().offset)
+	}
+
+	return &Rule{expr: expr, root: root}, nil
+}
+
+// MustCompile is like Compile but panics on error.
+func MustCompile(expr string) *Rule {
+	r, err := Compile(expr)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return r
+}
+
+// String returns the expression the rule was compiled from.
+func (r *Rule) String() string {
+	return r.expr
+}
+
+// Eval evaluates the rule with the given variables. This returns a non-nil
+// error if the expression references a variable that is not set.
+func (r *Rule) Eval(vars map[string]enum.Value) (bool, error) {
+	return r.root.eval(vars)
+}
+
+type node interface {
+	eval(vars map[string]enum.Value) (bool, error)
+}
+
+type andNode struct{ left, right node }
+
+func (n andNode) eval(vars map[string]enum.Value) (bool, error) {
+	left, err := n.left.eval(vars)
+	if err != nil || !left {
+		return false, err
+	}
+
+	return n.right.eval(vars)
+}
+
+type orNode struct{ left, right node }
+
+func (n orNode) eval(vars map[string]enum.Value) (bool, error) {
+	left, err := n.left.eval(vars)
+	if err != nil || left {
+		return left, err
+	}
+
+	return n.right.eval(vars)
+}
+
+type notNode struct{ operand node }
+
+func (n notNode) eval(vars map[string]enum.Value) (bool, error) {
+	v, err := n.operand.eval(vars)
+	if err != nil {
+		return false, err
+	}
+
+	return !v, nil
+}
+
+// inNode checks whether a variable matches any of the given names. It also
+// implements == and != (with a single name).
+type inNode struct {
+	variable string
+	names    []string
+	negate   bool
+
+	// declared is the declaration of the variable if the rule was compiled
+	// with CompileVars.
+	declared *Variable
+}
+
+func (n inNode) eval(vars map[string]enum.Value) (bool, error) {
+	v, ok := vars[n.variable]
+	if !ok || v == nil {
+		return false, fmt.Errorf("variable %s is not set", n.variable)
+	}
+
+	if n.declared != nil && !n.declared.is(v) {
+		return false, fmt.Errorf("variable %s holds %s instead of an enum of type %s",
+			n.variable, v.QualifiedName(), n.declared.typeName)
+	}
+
+	for _, name := range n.names {
+		if name == v.Name() || name == v.QualifiedName() {
+			return !n.negate, nil
+		}
+	}
+
+	return n.negate, nil
+}
+
+type tokenKind int
+
+const (
+	tokenIdent tokenKind = iota
+	tokenString
+	tokenOperator
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	offset int
+}
+
+func isIdentRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.'
+}
+
+func tokenize(expr string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(expr); {
+		r := rune(expr[i])
+
+		switch {
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			i++
+		case strings.HasPrefix(expr[i:], "&&"), strings.HasPrefix(expr[i:], "||"),
+			strings.HasPrefix(expr[i:], "=="), strings.HasPrefix(expr[i:], "!="):
+			tokens = append(tokens, token{tokenOperator, expr[i : i+2], i})
+			i += 2
+		case strings.ContainsRune("!()[],", r):
+			tokens = append(tokens, token{tokenOperator, expr[i : i+1], i})
+			i++
+		case r == '"':
+			s, err := strconv.QuotedPrefix(expr[i:])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at offset %d", i)
+			}
+
+			text, _ := strconv.Unquote(s)
+			tokens = append(tokens, token{tokenString, text, i})
+			i += len(s)
+		default:
+			start := i
+			for i < len(expr) {
+				r, size := utf8.DecodeRuneInString(expr[i:])
+				if !isIdentRune(r) {
+					break
+				}
+
+				i += size
+			}
+
+			if i == start {
+				r, _ := utf8.DecodeRuneInString(expr[i:])
+				return nil, fmt.Errorf("unexpected %q at offset %d", r, i)
+			}
+
+			tokens = append(tokens, token{tokenIdent, expr[start:i], start})
+		}
+	}
+
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+
+	// vars are the declared variables, or nil if they are not checked.
+	vars map[string]*Variable
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{kind: tokenOperator, text: "end of expression", offset: -1}
+	}
+
+	return p.tokens[p.pos]
+}
+
+// accept consumes the next token if it is the given operator or keyword.
+func (p *parser) accept(text string) bool {
+	if t := p.peek(); !p.done() && t.kind != tokenString && t.text == text {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if !p.accept(text) {
+		return p.unexpected(text)
+	}
+
+	return nil
+}
+
+func (p *parser) unexpected(expected string) error {
+	t := p.peek()
+	if t.offset < 0 {
+		return fmt.Errorf("expected %s, got end of expression", expected)
+	}
+
+	return fmt.Errorf("expected %s, got %s at offset %d", expected, t.text, t.offset)
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = orNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = andNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.accept("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return notNode{operand}, nil
+	}
+
+	if p.accept("(") {
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		return n, p.expect(")")
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	t := p.peek()
+	if p.done() || t.kind != tokenIdent || t.text == "in" || t.text == "not" {
+		return nil, p.unexpected("variable")
+	}
+
+	p.pos++
+
+	var declared *Variable
+	if p.vars != nil {
+		if declared = p.vars[t.text]; declared == nil {
+			return nil, fmt.Errorf("undeclared variable %s at offset %d", t.text, t.offset)
+		}
+	}
+
+	switch {
+	case p.accept("=="), p.accept("!="):
+		negate := p.tokens[p.pos-1].text == "!="
+
+		name, err := p.parseName(declared)
+		if err != nil {
+			return nil, err
+		}
+
+		return inNode{variable: t.text, names: []string{name}, negate: negate, declared: declared}, nil
+	case p.accept("in"):
+		names, err := p.parseList(declared)
+
+		return inNode{variable: t.text, names: names, declared: declared}, err
+	case p.accept("not"):
+		if err := p.expect("in"); err != nil {
+			return nil, err
+		}
+
+		names, err := p.parseList(declared)
+
+		return inNode{variable: t.text, names: names, negate: true, declared: declared}, err
+	}
+
+	return nil, p.unexpected("==, !=, in or not in")
+}
+
+func (p *parser) parseList(declared *Variable) ([]string, error) {
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for {
+		name, err := p.parseName(declared)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, name)
+
+		if p.accept("]") {
+			return names, nil
+		}
+
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseName parses a name compared with the given variable (nil if it is
+// not declared).
+func (p *parser) parseName(declared *Variable) (string, error) {
+	t := p.peek()
+	if p.done() || t.kind == tokenOperator {
+		return "", p.unexpected("name")
+	}
+
+	if declared != nil && !declared.names[t.text] {
+		return "", fmt.Errorf("unknown name %s for variable %s of type %s at offset %d",
+			t.text, declared.name, declared.typeName, t.offset)
+	}
+
+	p.pos++
+
+	return t.text, nil
+}
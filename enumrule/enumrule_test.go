@@ -0,0 +1,141 @@
+package enumrule
+
+import (
+	"testing"
+
+	"github.com/bruno-ga/enum"
+)
+
+type role int
+
+type permission int
+
+var (
+	_      = enum.New[role]("Unknown")
+	admin  = enum.New[role]("Admin")
+	user   = enum.New[role]("User")
+	guest  = enum.New[role]("Guest")
+	read   = enum.New[permission]("Read")
+	write  = enum.New[permission]("Write")
+	review = enum.New[permission]("billing:review")
+)
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		expr       string
+		role       enum.Value
+		permission enum.Value
+		expected   bool
+	}{
+		{`role in [Admin, User] && permission != Write`, admin, read, true},
+		{`role in [Admin, User] && permission != Write`, user, write, false},
+		{`role in [Admin, User] && permission != Write`, guest, read, false},
+		{`role == Admin || permission == Read`, guest, read, true},
+		{`role not in [Guest]`, user, read, true},
+		{`!(role == Admin) && !(permission == Write)`, user, read, true},
+		{`role == role.Admin`, admin, read, true},
+		{`permission == "billing:review"`, guest, review, true},
+		{`role == Admin || role == User && permission == Write`, admin, read, true},
+		{`(role == Admin || role == User) && permission == Write`, admin, read, false},
+	}
+
+	for _, test := range tests {
+		unchecked, err := Compile(test.expr)
+		if err != nil {
+			t.Fatalf("unexpected error compiling %s: %s", test.expr, err)
+		}
+
+		checked, err := CompileVars(test.expr, Var[role]("role"), Var[permission]("permission"))
+		if err != nil {
+			t.Fatalf("unexpected error compiling %s with variables: %s", test.expr, err)
+		}
+
+		for _, rule := range []*Rule{unchecked, checked} {
+			got, err := rule.Eval(map[string]enum.Value{"role": test.role, "permission": test.permission})
+			if err != nil {
+				t.Fatalf("unexpected error evaluating %s: %s", test.expr, err)
+			}
+
+			if got != test.expected {
+				t.Errorf("expected %s to be %v for %s and %s", test.expr, test.expected, test.role.Name(), test.permission.Name())
+			}
+		}
+	}
+}
+
+func TestEval_WrongType(t *testing.T) {
+	rule, err := CompileVars(`!(role == Admin)`, Var[role]("role"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := rule.Eval(map[string]enum.Value{"role": read})
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if got {
+		t.Errorf("expected false with an error, got true")
+	}
+}
+
+func TestEval_MissingVariable(t *testing.T) {
+	rule := MustCompile(`role == Admin && scope == Global`)
+
+	if _, err := rule.Eval(map[string]enum.Value{"role": admin}); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	// Evaluation short-circuits.
+	if _, err := rule.Eval(map[string]enum.Value{"role": user}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	rule = MustCompile(`!(scope == Global)`)
+
+	if got, err := rule.Eval(map[string]enum.Value{}); err == nil || got {
+		t.Errorf("expected false with an error, got %v and %v", got, err)
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	for _, expr := range []string{
+		``,
+		`role`,
+		`role ==`,
+		`role in Admin`,
+		`role in [Admin`,
+		`role in [Admin,]`,
+		`role not [Admin]`,
+		`(role == Admin`,
+		`role == Admin)`,
+		`role == Admin &&`,
+		`role == "Admin`,
+		`role == Admin # comment`,
+		`== Admin`,
+	} {
+		if _, err := Compile(expr); err == nil {
+			t.Errorf("expected error for %q, got nil", expr)
+		}
+	}
+}
+
+func TestCompileVars_Errors(t *testing.T) {
+	vars := []Variable{Var[role]("role"), Var[permission]("permission")}
+
+	for _, expr := range []string{
+		`permission != Wirte`,
+		`role in [Admin, Usr]`,
+		`role not in [Read]`,
+		`role == permission.Read`,
+		`scope == Global`,
+	} {
+		if _, err := CompileVars(expr, vars...); err == nil {
+			t.Errorf("expected error for %q, got nil", expr)
+		}
+
+		if _, err := Compile(expr); err != nil {
+			t.Errorf("unexpected error for %q without variables: %s", expr, err)
+		}
+	}
+}
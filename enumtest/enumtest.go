@@ -0,0 +1,81 @@
+// Package enumtest provides helpers for testing code that declares enums.
+package enumtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"golang.org/x/exp/constraints"
+)
+
+var update = flag.Bool("enumtest.update", false, "update enumtest golden files")
+
+// definition is the serialized form of the enums associated with a type.
+type definition[T constraints.Integer] struct {
+	Type   string     `json:"type"`
+	Values []value[T] `json:"values"`
+}
+
+type value[T constraints.Integer] struct {
+	Name       string `json:"name"`
+	ID         T      `json:"id"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+}
+
+// AssertStable fails the test if the names, IDs or deprecation status of the
+// enums associated with type T differ from the ones stored in the given golden
+// file, so accidentally renamed values or shifted IDs are caught. Running the
+// test with the -enumtest.update flag (re)writes the golden file instead:
+//
+//	func TestRoleStable(t *testing.T) {
+//		enumtest.AssertStable[Role](t, "testdata/role.golden.json")
+//	}
+func AssertStable[T constraints.Integer](t testing.TB, path string) {
+	t.Helper()
+
+	var tInstance T
+
+	d := definition[T]{Type: reflect.TypeOf(tInstance).String()}
+	for _, e := range enum.EnumsByType[T]() {
+		d.Values = append(d.Values, value[T]{
+			Name:       e.Name(),
+			ID:         e.ID(),
+			Deprecated: e.Deprecated(),
+		})
+	}
+
+	got, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got = append(got, '\n')
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("could not read golden file (run with -enumtest.update to create it): %s", err)
+	}
+
+	if !bytes.Equal(got, expected) {
+		t.Errorf("enums for type %s differ from golden file %s (run with -enumtest.update if this is intended)\ngot:\n%s\nexpected:\n%s",
+			d.Type, path, got, expected)
+	}
+}
@@ -0,0 +1,77 @@
+package enumtest
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"golang.org/x/exp/constraints"
+)
+
+type role int
+
+var (
+	_ = enum.New[role]("Unknown")
+	_ = enum.New[role]("Admin")
+	_ = enum.New[role]("Legacy", enum.Deprecated())
+)
+
+type recorder struct {
+	testing.TB
+
+	failed  bool
+	message string
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failed = true
+	r.message = fmt.Sprintf(format, args...)
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.failed = true
+	panic(fmt.Sprintf(format, args...))
+}
+
+func TestAssertStable(t *testing.T) {
+	AssertStable[role](t, "testdata/role.golden.json")
+}
+
+func TestAssertStable_Changed(t *testing.T) {
+	type other int
+
+	enum.New[other]("Unknown")
+
+	path := filepath.Join(t.TempDir(), "role.golden.json")
+	writeGolden[role](t, path)
+
+	defer func(previous bool) { *update = previous }(*update)
+	*update = false
+
+	r := &recorder{TB: t}
+	AssertStable[other](r, path)
+
+	if !r.failed {
+		t.Errorf("expected failure")
+	}
+}
+
+func TestAssertStable_Update(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "role.golden.json")
+	writeGolden[role](t, path)
+
+	defer func(previous bool) { *update = previous }(*update)
+	*update = false
+
+	AssertStable[role](t, path)
+}
+
+func writeGolden[T constraints.Integer](t *testing.T, path string) {
+	t.Helper()
+
+	defer func(previous bool) { *update = previous }(*update)
+	*update = true
+
+	AssertStable[T](t, path)
+}
@@ -0,0 +1,183 @@
+package enumtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"github.com/bruno-ga/enum/internal/jsonpath"
+)
+
+// AssertJSONEqual fails the test if the given JSON documents are not
+// equivalent. The type of v (usually a zero value of the type the documents
+// are decoded into) is used to find enums in the documents, which are
+// considered equal if they decode to the same value regardless of whether
+// they are represented by names or IDs. The given options are used for
+// decoding enums (for example, enum.HookAliases makes aliases equal to the
+// values they refer to):
+//
+//	enumtest.AssertJSONEqual(t, User{}, expected, actual, enum.HookLenient())
+//
+// Differences are reported with JSON pointers to the differing values.
+func AssertJSONEqual(t testing.TB, v any, expected, actual []byte, opts ...enum.HookOption) {
+	t.Helper()
+
+	var e, a any
+	if err := json.Unmarshal(expected, &e); err != nil {
+		t.Fatalf("invalid expected JSON: %s", err)
+	}
+
+	if err := json.Unmarshal(actual, &a); err != nil {
+		t.Fatalf("invalid actual JSON: %s", err)
+	}
+
+	n := normalizer{hook: enum.MapstructureHook(opts...)}
+	typ := reflect.TypeOf(v)
+
+	diffs := diffJSON("", n.normalize(typ, e), n.normalize(typ, a))
+	if len(diffs) > 0 {
+		t.Errorf("JSON documents differ:\n%s", strings.Join(diffs, "\n"))
+	}
+}
+
+type normalizer struct {
+	hook func(from, to reflect.Type, data any) (any, error)
+}
+
+// normalize replaces all enums in data, which is a decoded JSON value
+// corresponding to type t, with their names.
+func (n normalizer) normalize(t reflect.Type, data any) any {
+	if t == nil || data == nil {
+		return data
+	}
+
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	from := reflect.TypeOf(data)
+	if v, err := n.hook(from, t, data); err == nil && from != t && reflect.TypeOf(v) == t {
+		// Only enums are converted to the destination type by the hook.
+		return fmt.Sprint(v)
+	}
+
+	switch data := data.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(data))
+		for key, value := range data {
+			switch t.Kind() {
+			case reflect.Struct:
+				if f, ok := jsonpath.Field(t, key); ok {
+					value = n.normalize(f.Type, value)
+				}
+			case reflect.Map:
+				value = n.normalize(t.Elem(), value)
+			}
+
+			m[key] = value
+		}
+
+		return m
+	case []any:
+		s := make([]any, len(data))
+		for i, value := range data {
+			if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+				value = n.normalize(t.Elem(), value)
+			}
+
+			s[i] = value
+		}
+
+		return s
+	}
+
+	return data
+}
+
+// diffJSON returns a description of each difference between expected and
+// actual.
+func diffJSON(path string, expected, actual any) []string {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(e)+len(a))
+		for key := range e {
+			keys = append(keys, key)
+		}
+
+		for key := range a {
+			if _, ok := e[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Strings(keys)
+
+		var diffs []string
+		for _, key := range keys {
+			p := path + "/" + jsonpath.Escape(key)
+
+			ev, eok := e[key]
+			av, aok := a[key]
+
+			switch {
+			case !aok:
+				diffs = append(diffs, fmt.Sprintf("%s: missing, expected %s", p, marshal(ev)))
+			case !eok:
+				diffs = append(diffs, fmt.Sprintf("%s: unexpected %s", p, marshal(av)))
+			default:
+				diffs = append(diffs, diffJSON(p, ev, av)...)
+			}
+		}
+
+		return diffs
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			break
+		}
+
+		if len(e) != len(a) {
+			return []string{fmt.Sprintf("%s: expected %d elements, got %d", pathOrRoot(path), len(e), len(a))}
+		}
+
+		var diffs []string
+		for i := range e {
+			diffs = append(diffs, diffJSON(path+"/"+strconv.Itoa(i), e[i], a[i])...)
+		}
+
+		return diffs
+	}
+
+	if reflect.DeepEqual(expected, actual) {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("%s: expected %s, got %s", pathOrRoot(path), marshal(expected), marshal(actual))}
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "/"
+	}
+
+	return path
+}
+
+func marshal(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(data)
+}
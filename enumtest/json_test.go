@@ -0,0 +1,54 @@
+package enumtest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+)
+
+type status int
+
+type statusEnum enum.Enum[status]
+
+var (
+	_      = statusEnum(enum.New[status]("Unknown"))
+	active = statusEnum(enum.New[status]("Active"))
+	_      = statusEnum(enum.New[status]("Disabled"))
+)
+
+type account struct {
+	Name     string                `json:"name"`
+	Status   statusEnum            `json:"status"`
+	Previous []statusEnum          `json:"previous"`
+	ByRegion map[string]statusEnum `json:"by_region"`
+	Parent   *account              `json:"parent,omitempty"`
+}
+
+func TestAssertJSONEqual(t *testing.T) {
+	expected := []byte(`{"name":"a","status":"Active","previous":["Unknown","Disabled"],"by_region":{"eu":"Active"},"parent":{"status":"Disabled"}}`)
+	actual := []byte(`{"name":"a","status":1,"previous":[0,"disabled"],"by_region":{"eu":"enabled"},"parent":{"status":2}}`)
+
+	AssertJSONEqual(t, account{}, expected, actual,
+		enum.HookLenient(), enum.HookAliases(map[string]statusEnum{"enabled": active}))
+}
+
+func TestAssertJSONEqual_Differences(t *testing.T) {
+	expected := []byte(`{"name":"a","status":"Active","previous":["Unknown"],"by_region":{"eu":"Active"}}`)
+	actual := []byte(`{"name":"b","status":2,"previous":[],"by_region":{"us":1}}`)
+
+	r := &recorder{TB: t}
+	AssertJSONEqual(r, &account{}, expected, actual)
+
+	for _, diff := range []string{
+		`/name: expected "a", got "b"`,
+		`/status: expected "Active", got "Disabled"`,
+		`/previous: expected 1 elements, got 0`,
+		`/by_region/eu: missing, expected "Active"`,
+		`/by_region/us: unexpected "Active"`,
+	} {
+		if !strings.Contains(r.message, diff) {
+			t.Errorf("expected message to contain %q, got:\n%s", diff, r.message)
+		}
+	}
+}
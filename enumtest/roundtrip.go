@@ -0,0 +1,94 @@
+package enumtest
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"golang.org/x/exp/constraints"
+)
+
+// RoundTrip checks that every enum associated with type T (as returned by
+// enum.EnumsByType) is decoded back to the exact same value after being
+// encoded with JSON, text, binary (including gob) and SQL encodings.
+func RoundTrip[T constraints.Integer](t testing.TB) {
+	t.Helper()
+
+	for _, e := range enum.EnumsByType[T]() {
+		roundTrip(t, e, "JSON", func() (enum.Enum[T], error) {
+			data, err := json.Marshal(e)
+			if err != nil {
+				return enum.Enum[T]{}, err
+			}
+
+			var got enum.Enum[T]
+			err = json.Unmarshal(data, &got)
+
+			return got, err
+		})
+
+		roundTrip(t, e, "text", func() (enum.Enum[T], error) {
+			data, err := e.MarshalText()
+			if err != nil {
+				return enum.Enum[T]{}, err
+			}
+
+			var got enum.Enum[T]
+			err = got.UnmarshalText(data)
+
+			return got, err
+		})
+
+		roundTrip(t, e, "binary", func() (enum.Enum[T], error) {
+			data, err := e.MarshalBinary()
+			if err != nil {
+				return enum.Enum[T]{}, err
+			}
+
+			var got enum.Enum[T]
+			err = got.UnmarshalBinary(data)
+
+			return got, err
+		})
+
+		roundTrip(t, e, "gob", func() (enum.Enum[T], error) {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(e); err != nil {
+				return enum.Enum[T]{}, err
+			}
+
+			var got enum.Enum[T]
+			err := gob.NewDecoder(&buf).Decode(&got)
+
+			return got, err
+		})
+
+		roundTrip(t, e, "SQL", func() (enum.Enum[T], error) {
+			value, err := e.Value()
+			if err != nil {
+				return enum.Enum[T]{}, err
+			}
+
+			var got enum.Enum[T]
+			err = got.Scan(value)
+
+			return got, err
+		})
+	}
+}
+
+func roundTrip[T constraints.Integer](t testing.TB, e enum.Enum[T], encoding string, f func() (enum.Enum[T], error)) {
+	t.Helper()
+
+	got, err := f()
+	if err != nil {
+		t.Errorf("%s round trip of %s failed: %s", encoding, e, err)
+		return
+	}
+
+	if got != e {
+		t.Errorf("%s round trip of %s returned a different value (%v)", encoding, e, got)
+	}
+}
@@ -0,0 +1,8 @@
+package enumtest
+
+import "testing"
+
+func TestRoundTrip(t *testing.T) {
+	RoundTrip[role](t)
+	RoundTrip[status](t)
+}
@@ -0,0 +1,102 @@
+// Package enumtime registers time.Weekday and time.Month values as enums, so
+// configuration (for example, schedules) can use readable names like
+// "monday" and "january" with the same parsing, validation and encoding as
+// other enums:
+//
+//	type Schedule struct {
+//		Days []enumtime.Weekday `json:"days"`
+//	}
+//
+// Names are the lower case English names returned by the String methods of
+// the time types and are matched case insensitively. IDs are the time values
+// themselves, so Time converts back without a lookup.
+package enumtime
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bruno-ga/enum"
+)
+
+// Weekday is a time.Weekday registered as an enum.
+type Weekday enum.Enum[time.Weekday]
+
+// Month is a time.Month registered as an enum.
+type Month enum.Enum[time.Month]
+
+var (
+	weekdays = register(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	months   = register(time.January, time.February, time.March, time.April, time.May, time.June, time.July,
+		time.August, time.September, time.October, time.November, time.December)
+)
+
+func register[T enum.Stringer](values ...T) []enum.Enum[T] {
+	enum.SetNormalizer[T](strings.ToLower)
+
+	return enum.Wrap(values...)
+}
+
+// Weekdays returns all weekdays, starting with Sunday.
+func Weekdays() []Weekday {
+	values := make([]Weekday, 0, len(weekdays))
+	for _, e := range weekdays {
+		values = append(values, Weekday(e))
+	}
+
+	return values
+}
+
+// Months returns all months, starting with January.
+func Months() []Month {
+	values := make([]Month, 0, len(months))
+	for _, e := range months {
+		values = append(values, Month(e))
+	}
+
+	return values
+}
+
+// WeekdayOf returns the Weekday for the given time.Weekday. This panics if it
+// is not a valid weekday.
+func WeekdayOf(d time.Weekday) Weekday {
+	if d < time.Sunday || d > time.Saturday {
+		panic("invalid weekday " + d.String())
+	}
+
+	return Weekday(weekdays[d])
+}
+
+// MonthOf returns the Month for the given time.Month. This panics if it is
+// not a valid month.
+func MonthOf(m time.Month) Month {
+	if m < time.January || m > time.December {
+		panic("invalid month " + m.String())
+	}
+
+	return Month(months[m-time.January])
+}
+
+// ParseWeekday returns the weekday with the given name, ignoring case.
+func ParseWeekday(name string) (Weekday, error) {
+	e, err := enum.EnumByTypeAndName[time.Weekday](name)
+
+	return Weekday(e), err
+}
+
+// ParseMonth returns the month with the given name, ignoring case.
+func ParseMonth(name string) (Month, error) {
+	e, err := enum.EnumByTypeAndName[time.Month](name)
+
+	return Month(e), err
+}
+
+// Time returns the time.Weekday of this Weekday.
+func (d Weekday) Time() time.Weekday {
+	return d.ID()
+}
+
+// Time returns the time.Month of this Month.
+func (m Month) Time() time.Month {
+	return m.ID()
+}
@@ -0,0 +1,79 @@
+package enumtime
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWeekday(t *testing.T) {
+	if n := len(Weekdays()); n != 7 {
+		t.Errorf("expected 7 weekdays, got %d", n)
+	}
+
+	monday := WeekdayOf(time.Monday)
+	if monday.Name() != "monday" || monday.Time() != time.Monday {
+		t.Errorf("unexpected weekday %s (%d)", monday.Name(), monday.Time())
+	}
+
+	if d, err := ParseWeekday("Monday"); err != nil || d != monday {
+		t.Errorf("expected monday, got %v (%v)", d, err)
+	}
+
+	if _, err := ParseWeekday("someday"); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+
+	WeekdayOf(7)
+}
+
+func TestMonth(t *testing.T) {
+	months := Months()
+	if len(months) != 12 || months[0].Time() != time.January || months[11].Time() != time.December {
+		t.Errorf("unexpected months %v", months)
+	}
+
+	if m, err := ParseMonth("JANUARY"); err != nil || m != MonthOf(time.January) {
+		t.Errorf("expected january, got %v (%v)", m, err)
+	}
+
+	if m := MonthOf(time.August); m.Name() != "august" {
+		t.Errorf("unexpected month %s", m.Name())
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type schedule struct {
+		Days   []Weekday `json:"days"`
+		Months []Month   `json:"months"`
+	}
+
+	var s schedule
+	if err := json.Unmarshal([]byte(`{"days":["monday","Friday"],"months":["january"]}`), &s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := schedule{
+		Days:   []Weekday{WeekdayOf(time.Monday), WeekdayOf(time.Friday)},
+		Months: []Month{MonthOf(time.January)},
+	}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("expected %v, got %v", expected, s)
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if string(data) != `{"days":["monday","friday"],"months":["january"]}` {
+		t.Errorf("unexpected json %s", data)
+	}
+}
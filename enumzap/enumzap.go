@@ -0,0 +1,48 @@
+// Package enumzap adapts enums to zap structured logging. Enums are logged as
+// objects holding their name and ID:
+//
+//	logger.Info("user created", enumzap.Field("role", user.Role))
+//	// {"msg":"user created","role":{"name":"Admin","id":1}}
+package enumzap
+
+import (
+	"github.com/bruno-ga/enum"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/exp/constraints"
+)
+
+// Field returns a zap.Field logging the given value under the given key.
+func Field[E enum.EnumType[T], T constraints.Integer](key string, value E) zap.Field {
+	return zap.Object(key, Object(value))
+}
+
+// Object returns a zapcore.ObjectMarshaler for the given value, for use with
+// zap.Object, zap.Objects or zapcore.ObjectEncoder.AddObject. The ID of
+// unrecognized enums (see enum.Open) is not logged and uninitialized values
+// are logged as an empty object.
+func Object[E enum.EnumType[T], T constraints.Integer](value E) zapcore.ObjectMarshaler {
+	return object[T](enum.Enum[T](value))
+}
+
+type object[T constraints.Integer] enum.Enum[T]
+
+// MarshalLogObject implements the zapcore.ObjectMarshaler interface.
+func (o object[T]) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	e := enum.Enum[T](o)
+	if !e.Valid() {
+		return nil
+	}
+
+	enc.AddString("name", e.Name())
+
+	if !e.Unrecognized() {
+		if id := e.ID(); id < 0 {
+			enc.AddInt64("id", int64(id))
+		} else {
+			enc.AddUint64("id", uint64(id))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package enumzap
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type role int
+
+var (
+	_     = enum.New[role]("Guest")
+	admin = enum.New[role]("Admin")
+)
+
+type roleEnum enum.Enum[role]
+
+type negative int8
+
+var below = enum.NewWithID[negative]("Below", -2)
+
+func newLogger(buf *bytes.Buffer) *zap.Logger {
+	config := zap.NewProductionEncoderConfig()
+	config.TimeKey = ""
+	config.LevelKey = ""
+
+	return zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(config), zapcore.AddSync(buf), zap.InfoLevel))
+}
+
+func TestField(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newLogger(&buf)
+
+	logger.Info("x",
+		Field("role", admin),
+		Field("derived", roleEnum(admin)),
+		Field("negative", below),
+		Field("empty", enum.Enum[role]{}),
+	)
+
+	expected := `{"msg":"x","role":{"name":"Admin","id":1},"derived":{"name":"Admin","id":1},"negative":{"name":"Below","id":-2},"empty":{}}` + "\n"
+	if s := buf.String(); s != expected {
+		t.Errorf("expected %s, got %s", expected, s)
+	}
+}
@@ -0,0 +1,19 @@
+module github.com/bruno-ga/enum/enumzap
+
+go 1.18
+
+require (
+	github.com/bruno-ga/enum v0.0.0
+	go.uber.org/zap v1.23.0
+	golang.org/x/exp v0.0.0-20220518171630-0b5c67f07fdf
+)
+
+require (
+	go.uber.org/atomic v1.7.0 // indirect
+	go.uber.org/multierr v1.6.0 // indirect
+	golang.org/x/text v0.21.0 // indirect
+	golang.org/x/time v0.6.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/bruno-ga/enum => ../
@@ -0,0 +1,40 @@
+// Package enumzerolog adapts enums to zerolog structured logging. Enums are
+// logged as objects holding their name and ID:
+//
+//	log.Info().Object("role", enumzerolog.Object(user.Role)).Msg("user created")
+//	// {"level":"info","role":{"name":"Admin","id":1},"message":"user created"}
+package enumzerolog
+
+import (
+	"github.com/bruno-ga/enum"
+	"github.com/rs/zerolog"
+	"golang.org/x/exp/constraints"
+)
+
+// Object returns a zerolog.LogObjectMarshaler for the given value, for use
+// with zerolog.Event.Object or zerolog.Context.Object. The ID of unrecognized
+// enums (see enum.Open) is not logged and uninitialized values are logged as
+// an empty object.
+func Object[E enum.EnumType[T], T constraints.Integer](value E) zerolog.LogObjectMarshaler {
+	return object[T](enum.Enum[T](value))
+}
+
+type object[T constraints.Integer] enum.Enum[T]
+
+// MarshalZerologObject implements the zerolog.LogObjectMarshaler interface.
+func (o object[T]) MarshalZerologObject(event *zerolog.Event) {
+	e := enum.Enum[T](o)
+	if !e.Valid() {
+		return
+	}
+
+	event.Str("name", e.Name())
+
+	if !e.Unrecognized() {
+		if id := e.ID(); id < 0 {
+			event.Int64("id", int64(id))
+		} else {
+			event.Uint64("id", uint64(id))
+		}
+	}
+}
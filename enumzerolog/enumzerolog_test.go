@@ -0,0 +1,39 @@
+package enumzerolog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+	"github.com/rs/zerolog"
+)
+
+type role int
+
+var (
+	_     = enum.New[role]("Guest")
+	admin = enum.New[role]("Admin")
+)
+
+type roleEnum enum.Enum[role]
+
+type negative int8
+
+var below = enum.NewWithID[negative]("Below", -2)
+
+func TestObject(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+
+	logger.Info().
+		Object("role", Object(admin)).
+		Object("derived", Object(roleEnum(admin))).
+		Object("negative", Object(below)).
+		Object("empty", Object(enum.Enum[role]{})).
+		Msg("x")
+
+	expected := `{"level":"info","role":{"name":"Admin","id":1},"derived":{"name":"Admin","id":1},"negative":{"name":"Below","id":-2},"empty":{},"message":"x"}` + "\n"
+	if s := buf.String(); s != expected {
+		t.Errorf("expected %s, got %s", expected, s)
+	}
+}
@@ -0,0 +1,20 @@
+module github.com/bruno-ga/enum/enumzerolog
+
+go 1.18
+
+require (
+	github.com/bruno-ga/enum v0.0.0
+	github.com/rs/zerolog v1.33.0
+	golang.org/x/exp v0.0.0-20220518171630-0b5c67f07fdf
+)
+
+require (
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.20 // indirect
+	golang.org/x/sys v0.19.0 // indirect
+	golang.org/x/text v0.21.0 // indirect
+	golang.org/x/time v0.6.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/bruno-ga/enum => ../
@@ -0,0 +1,133 @@
+package enum
+
+import (
+	"errors"
+	"strconv"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Metadata keys set by the HTTPStatus and GRPCCode options.
+const (
+	HTTPStatusKey = "http_status"
+	GRPCCodeKey   = "grpc_code"
+)
+
+// Code is an enum associated with type T used as an error code. It implements
+// the error interface, so codes can be returned directly, wrapped with
+// WrapError and checked with errors.Is:
+//
+//	type ErrorCode int
+//
+//	var (
+//		NotFound = enum.Code[ErrorCode](enum.New[ErrorCode]("NotFound", enum.HTTPStatus(404), enum.GRPCCode(5)))
+//		Conflict = enum.Code[ErrorCode](enum.New[ErrorCode]("Conflict", enum.HTTPStatus(409), enum.GRPCCode(6)))
+//	)
+//
+//	if errors.Is(err, NotFound) {
+//		...
+//	}
+type Code[T constraints.Integer] Enum[T]
+
+// Error implements the error interface. It returns the name of the code.
+func (c Code[T]) Error() string {
+	return c.Name()
+}
+
+// CodedError is an error associated with a Code. It is returned by
+// WrapError.
+type CodedError[T constraints.Integer] struct {
+	Code Code[T]
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *CodedError[T]) Error() string {
+	return e.Code.Name() + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *CodedError[T]) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if target is the code of this error, so errors.Is(err,
+// code) works for wrapped errors.
+func (e *CodedError[T]) Is(target error) bool {
+	c, ok := target.(Code[T])
+
+	return ok && c == e.Code
+}
+
+// WrapError returns an error associating err with the given code, or nil if
+// err is nil. The code can be retrieved with CodeOf and checked with
+// errors.Is.
+func WrapError[E EnumType[T], T constraints.Integer](err error, code E) error {
+	if err == nil {
+		return nil
+	}
+
+	c := Code[T](code)
+	if !c.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return &CodedError[T]{Code: c, Err: err}
+}
+
+// CodeOf returns the first code associated with type T in the chain of err
+// (see WrapError) and true, or false if there is none.
+func CodeOf[T constraints.Integer](err error) (Code[T], bool) {
+	for err != nil {
+		switch e := err.(type) {
+		case Code[T]:
+			return e, true
+		case *CodedError[T]:
+			return e.Code, true
+		}
+
+		err = errors.Unwrap(err)
+	}
+
+	return Code[T]{}, false
+}
+
+// HTTPStatus associates the given HTTP status with the Enum (as metadata with
+// the HTTPStatusKey key), for use with HTTPStatusOf.
+func HTTPStatus(status int) Option {
+	return Metadata(HTTPStatusKey, strconv.Itoa(status))
+}
+
+// GRPCCode associates the given gRPC status code (a codes.Code value) with the
+// Enum (as metadata with the GRPCCodeKey key), for use with GRPCCodeOf.
+func GRPCCode(code uint32) Option {
+	return Metadata(GRPCCodeKey, strconv.FormatUint(uint64(code), 10))
+}
+
+// HTTPStatusOf returns the HTTP status associated with the code of err (see
+// CodeOf and HTTPStatus), or 500 (Internal Server Error) if there is none.
+func HTTPStatusOf[T constraints.Integer](err error) int {
+	if c, ok := CodeOf[T](err); ok {
+		if value, ok := c.MetadataValue(HTTPStatusKey); ok {
+			if status, err := strconv.Atoi(value); err == nil {
+				return status
+			}
+		}
+	}
+
+	return defaultStatus.HTTP
+}
+
+// GRPCCodeOf returns the gRPC status code associated with the code of err
+// (see CodeOf and GRPCCode), or 2 (Unknown) if there is none.
+func GRPCCodeOf[T constraints.Integer](err error) uint32 {
+	if c, ok := CodeOf[T](err); ok {
+		if value, ok := c.MetadataValue(GRPCCodeKey); ok {
+			if code, err := strconv.ParseUint(value, 10, 32); err == nil {
+				return uint32(code)
+			}
+		}
+	}
+
+	return defaultStatus.GRPC
+}
@@ -0,0 +1,57 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestCode(t *testing.T) {
+	type errorCode int
+
+	notFound := Code[errorCode](New[errorCode]("NotFound", HTTPStatus(404), GRPCCode(5)))
+	conflict := Code[errorCode](New[errorCode]("Conflict"))
+
+	if WrapError(nil, notFound) != nil {
+		t.Errorf("expected nil error")
+	}
+
+	err := fmt.Errorf("loading user: %w", WrapError(io.EOF, notFound))
+
+	if s := err.Error(); s != "loading user: NotFound: EOF" {
+		t.Errorf("unexpected message %s", s)
+	}
+
+	if !errors.Is(err, notFound) || errors.Is(err, conflict) || !errors.Is(err, io.EOF) {
+		t.Errorf("unexpected errors.Is results for %s", err)
+	}
+
+	if c, ok := CodeOf[errorCode](err); !ok || c != notFound {
+		t.Errorf("expected %s, got %v (%v)", notFound, c, ok)
+	}
+
+	if c, ok := CodeOf[errorCode](conflict); !ok || c != conflict {
+		t.Errorf("expected %s, got %v (%v)", conflict, c, ok)
+	}
+
+	if _, ok := CodeOf[errorCode](io.EOF); ok {
+		t.Errorf("expected no code")
+	}
+
+	if status := HTTPStatusOf[errorCode](err); status != 404 {
+		t.Errorf("expected 404, got %d", status)
+	}
+
+	if code := GRPCCodeOf[errorCode](err); code != 5 {
+		t.Errorf("expected 5, got %d", code)
+	}
+
+	if status := HTTPStatusOf[errorCode](conflict); status != 500 {
+		t.Errorf("expected 500, got %d", status)
+	}
+
+	if code := GRPCCodeOf[errorCode](io.EOF); code != 2 {
+		t.Errorf("expected 2, got %d", code)
+	}
+}
@@ -0,0 +1,204 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Bind associates the given value, usually an event type, with the type of
+// the given payload, so payloads can be created and decoded from the value
+// alone (see NewPayload and Envelope):
+//
+//	func init() {
+//		enum.Bind(UserCreated, UserCreatedPayload{})
+//		enum.Bind(UserDeleted, UserDeletedPayload{})
+//	}
+//
+// Only the type of the payload is used. This panics if the value is invalid
+// or already bound.
+func Bind[E EnumType[T], T constraints.Integer](value E, payload any) {
+	e := Enum[T](value)
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if payload == nil {
+		panic("payload cannot be nil")
+	}
+
+	if err := getOrCreateSetForType[T]().Bind(e.internalEnum, reflect.TypeOf(payload)); err != nil {
+		panic(err.Error())
+	}
+}
+
+// PayloadType returns the payload type the given value is bound to (see Bind)
+// and true, or false if it is not bound.
+func PayloadType[E EnumType[T], T constraints.Integer](value E) (reflect.Type, bool) {
+	e := Enum[T](value)
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if e.set == nil {
+		return nil, false
+	}
+
+	return e.set.Payload(e.internalEnum)
+}
+
+// NewPayload returns a pointer to a new zero payload of the type the given
+// value is bound to (see Bind), for example a *UserCreatedPayload for
+// UserCreated. This returns a non-nil error if the value is not bound.
+func NewPayload[E EnumType[T], T constraints.Integer](value E) (any, error) {
+	t, ok := PayloadType(value)
+	if !ok {
+		return nil, fmt.Errorf("enum %s of type %s is not bound to a payload type", Enum[T](value).Name(), getTypeName[T]())
+	}
+
+	return reflect.New(t).Interface(), nil
+}
+
+// Envelope is a JSON envelope holding a payload and the value (usually an
+// event type) identifying its type, encoded as:
+//
+//	{"type":"UserCreated","payload":{...}}
+//
+// When unmarshaling, the payload is decoded into a new payload of the type
+// the value is bound to (see NewPayload), so Payload holds a pointer to it
+// (for example, a *UserCreatedPayload). When marshaling, Payload must be of
+// the bound type or a pointer to it.
+type Envelope[T constraints.Integer] struct {
+	Type    Enum[T]
+	Payload any
+}
+
+type jsonEnvelope[T constraints.Integer] struct {
+	Type    Enum[T]         `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e Envelope[T]) MarshalJSON() ([]byte, error) {
+	if !e.Type.Valid() {
+		return nil, fmt.Errorf("missing envelope type")
+	}
+
+	t, ok := PayloadType(e.Type)
+	if !ok {
+		return nil, fmt.Errorf("enum %s of type %s is not bound to a payload type", e.Type.Name(), getTypeName[T]())
+	}
+
+	pt := reflect.TypeOf(e.Payload)
+	if pt != t && pt != reflect.PtrTo(t) {
+		return nil, fmt.Errorf("payload for %s must be of type %s, got %s", e.Type.Name(), t, pt)
+	}
+
+	payload, err := json.Marshal(e.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(jsonEnvelope[T]{Type: e.Type, Payload: payload})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *Envelope[T]) UnmarshalJSON(data []byte) error {
+	var envelope jsonEnvelope[T]
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return err
+	}
+
+	if !envelope.Type.Valid() {
+		return fmt.Errorf("missing envelope type")
+	}
+
+	payload, err := NewPayload(envelope.Type)
+	if err != nil {
+		return err
+	}
+
+	if len(envelope.Payload) > 0 {
+		if err := json.Unmarshal(envelope.Payload, payload); err != nil {
+			return fmt.Errorf("invalid payload for %s: %w", envelope.Type.Name(), err)
+		}
+	}
+
+	e.Type = envelope.Type
+	e.Payload = payload
+
+	return nil
+}
+
+// WithPayload returns the enums associated with type T bound to a payload
+// type (see Bind) that implements or is assignable to the given type,
+// directly or through a pointer, sorted by ID:
+//
+//	describable := enum.WithPayload[EventType](reflect.TypeOf((*Describer)(nil)).Elem())
+func WithPayload[T constraints.Integer](target reflect.Type) []Enum[T] {
+	return Where(func(e Enum[T]) bool {
+		t, ok := PayloadType(e)
+
+		return ok && payloadMatches(t, target)
+	})
+}
+
+// payloadLister is implemented by all sets.
+type payloadLister interface {
+	payloadTypes() []reflect.Type
+}
+
+// TypesImplementing returns the types whose enums include at least one bound
+// to a payload type (see Bind) that implements or is assignable to the given
+// type, directly or through a pointer, sorted by name. It is intended for
+// framework code discovering enum types, for example to serve their
+// documentation:
+//
+//	describers := enum.TypesImplementing(reflect.TypeOf((*Describer)(nil)).Elem())
+//	http.Handle("/enums", enumdoc.Handler(describers...))
+func TypesImplementing(target reflect.Type) []TypeSelector {
+	setByTypeMu.RLock()
+	sets := make(map[reflect.Type]any, len(setByType))
+	for t, s := range setByType {
+		sets[t] = s
+	}
+	setByTypeMu.RUnlock()
+
+	var types []TypeSelector
+
+	for t, s := range sets {
+		for _, p := range s.(payloadLister).payloadTypes() {
+			if payloadMatches(p, target) {
+				types = append(types, TypeSelector{t})
+				break
+			}
+		}
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].t.String() < types[j].t.String()
+	})
+
+	return types
+}
+
+// payloadMatches returns true if the given payload type, or a pointer to it,
+// implements or is assignable to the target type.
+func payloadMatches(payload, target reflect.Type) bool {
+	return target != nil && (payload.AssignableTo(target) || reflect.PtrTo(payload).AssignableTo(target))
+}
+
+func (s *internalSet[T]) payloadTypes() []reflect.Type {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	types := make([]reflect.Type, 0, len(s.payloads))
+	for _, t := range s.payloads {
+		types = append(types, t)
+	}
+
+	return types
+}
@@ -0,0 +1,135 @@
+package enum
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type eventType int
+
+type userCreated struct {
+	Name string `json:"name"`
+}
+
+type userDeleted struct {
+	Reason string `json:"reason"`
+}
+
+var (
+	userCreatedEvent = New[eventType]("UserCreated")
+	userDeletedEvent = New[eventType]("UserDeleted")
+	unboundEvent     = New[eventType]("Unbound")
+)
+
+func init() {
+	Bind(userCreatedEvent, userCreated{})
+	Bind(userDeletedEvent, userDeleted{})
+}
+
+func TestBind(t *testing.T) {
+	if pt, ok := PayloadType(userCreatedEvent); !ok || pt != reflect.TypeOf(userCreated{}) {
+		t.Errorf("unexpected payload type %v (%v)", pt, ok)
+	}
+
+	payload, err := NewPayload(userDeletedEvent)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := payload.(*userDeleted); !ok {
+		t.Errorf("expected *userDeleted, got %T", payload)
+	}
+
+	if _, err := NewPayload(unboundEvent); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	expectPanic(t, func() {
+		Bind(userCreatedEvent, userDeleted{})
+	})
+}
+
+func TestEnvelope(t *testing.T) {
+	data, err := json.Marshal(Envelope[eventType]{Type: userCreatedEvent, Payload: userCreated{Name: "ana"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if s := string(data); s != `{"type":"UserCreated","payload":{"name":"ana"}}` {
+		t.Errorf("unexpected envelope %s", s)
+	}
+
+	var e Envelope[eventType]
+	if err := json.Unmarshal([]byte(`{"type":"UserDeleted","payload":{"reason":"spam"}}`), &e); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if e.Type != userDeletedEvent || !reflect.DeepEqual(e.Payload, &userDeleted{Reason: "spam"}) {
+		t.Errorf("unexpected envelope %+v", e)
+	}
+
+	if _, err := json.Marshal(Envelope[eventType]{Type: userCreatedEvent, Payload: &userDeleted{}}); err == nil {
+		t.Errorf("expected error for mismatched payload, got nil")
+	}
+
+	if _, err := json.Marshal(Envelope[eventType]{Type: userCreatedEvent, Payload: &userCreated{}}); err != nil {
+		t.Errorf("unexpected error for pointer payload: %s", err)
+	}
+
+	for _, data := range []string{`{"type":"Unbound","payload":{}}`, `{"type":"Other"}`, `{"payload":{}}`, `{"type":"UserCreated","payload":[]}`} {
+		if err := json.Unmarshal([]byte(data), &e); err == nil {
+			t.Errorf("expected error for %s, got nil", data)
+		}
+	}
+}
+
+type payloadDescriber interface {
+	Describe() string
+}
+
+func (p *userDeleted) Describe() string {
+	return "A user was deleted: " + p.Reason
+}
+
+var payloadDescriberType = reflect.TypeOf((*payloadDescriber)(nil)).Elem()
+
+func TestWithPayload(t *testing.T) {
+	if got := WithPayload[eventType](payloadDescriberType); !reflect.DeepEqual(got, []Enum[eventType]{userDeletedEvent}) {
+		t.Errorf("expected %s, got %v", userDeletedEvent, got)
+	}
+
+	if got := WithPayload[eventType](reflect.TypeOf(userCreated{})); !reflect.DeepEqual(got, []Enum[eventType]{userCreatedEvent}) {
+		t.Errorf("expected %s, got %v", userCreatedEvent, got)
+	}
+
+	if got := WithPayload[eventType](reflect.TypeOf(0)); len(got) != 0 {
+		t.Errorf("expected no enums, got %v", got)
+	}
+}
+
+func TestTypesImplementing(t *testing.T) {
+	type command int
+
+	Bind(New[command]("Create"), userCreated{})
+
+	types := TypesImplementing(payloadDescriberType)
+
+	found := false
+	for _, ts := range types {
+		switch ts {
+		case TypeOf[eventType]():
+			found = true
+		case TypeOf[command]():
+			t.Errorf("unexpected type %s", ts.t)
+		}
+	}
+
+	if !found {
+		t.Errorf("expected %s in %v", TypeOf[eventType]().t, types)
+	}
+
+	if types := TypesImplementing(nil); len(types) != 0 {
+		t.Errorf("expected no types, got %v", types)
+	}
+}
@@ -0,0 +1,43 @@
+package enum
+
+import "golang.org/x/exp/constraints"
+
+// NewWithID is like New but uses the given ID instead of one based on the
+// registration order. This panics if the ID is already used by another enum
+// associated with type T. The ID can be negative, for sentinel values:
+//
+//	var Unset = enum.NewWithID[Priority]("Unset", -1)
+//
+// IDs based on the registration order start at 0 and are never negative, so
+// they never collide with such sentinels. For signed types they are exhausted
+// once the highest positive value of T is used, even if negative values are
+// still available.
+func NewWithID[T constraints.Integer](name string, id T, opts ...Option) Enum[T] {
+	if name == "" {
+		panic(emptyName[T](callSite(1)))
+	}
+
+	attrs := attributes{registeredAt: callSite(1)}
+	for _, opt := range opts {
+		opt(&attrs)
+	}
+
+	s := getOrCreateSetForType[T]()
+
+	return Enum[T]{internalEnumWrapper[T]{s.AddWithID(name, id, attrs)}}
+}
+
+// RequireExplicitIDs makes New panic for type T unless its ID does not depend
+// on the registration order (because the HashID option is used). All enums of
+// the type must then be created with NewWithID (or with Wrap or
+// LoadDefinitions with explicit IDs), so IDs that are persisted can never be
+// silently shifted by adding a value in the middle of a declaration block.
+//
+// It is usually called from an init function of the package that declares the
+// enums. It panics if enums with IDs based on the registration order were
+// already registered.
+func RequireExplicitIDs[T constraints.Integer]() {
+	if err := getOrCreateSetForType[T]().RequireExplicitIDs(); err != nil {
+		panic(err.Error())
+	}
+}
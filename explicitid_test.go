@@ -0,0 +1,102 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+type explicitRole int
+
+var (
+	explicitAdmin = NewWithID[explicitRole]("Admin", 10)
+	explicitUser  = NewWithID[explicitRole]("User", 20)
+	explicitGuest = New[explicitRole]("Guest", HashID())
+)
+
+func init() {
+	RequireExplicitIDs[explicitRole]()
+}
+
+func TestNewWithID(t *testing.T) {
+	type withID int
+
+	a := NewWithID[withID]("A", 5)
+	b := New[withID]("B")
+
+	if a.ID() != 5 || b.ID() != 0 {
+		t.Errorf("expected IDs 5 and 0, got %d and %d", a.ID(), b.ID())
+	}
+
+	expectPanic(t, func() {
+		NewWithID[withID]("C", 5)
+	})
+
+	expectPanic(t, func() {
+		NewWithID[withID]("", 6)
+	})
+}
+
+func TestNewWithID_Negative(t *testing.T) {
+	type sentinel int8
+
+	unset := NewWithID[sentinel]("Unset", -1)
+
+	// Auto-generated IDs are not negative, so all 128 non-negative IDs are
+	// available.
+	for i := 0; i < 128; i++ {
+		if e := New[sentinel](fmt.Sprintf("Value%d", i)); e.ID() != sentinel(i) {
+			t.Fatalf("expected ID %d, got %d", i, e.ID())
+		}
+	}
+
+	expectPanic(t, func() {
+		New[sentinel]("Overflow")
+	})
+
+	lowest := NewWithID[sentinel]("Min", -128)
+
+	data, err := json.Marshal(map[string]any{"unset": unset, "min": lowest})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var decoded map[string]Enum[sentinel]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if decoded["unset"] != unset || decoded["min"] != lowest {
+		t.Errorf("unexpected values %v", decoded)
+	}
+
+	if maxID, _ := getOrCreateSetForType[sentinel]().MaxID(); maxID != 127 {
+		t.Errorf("expected max ID 127, got %d", maxID)
+	}
+}
+
+func TestRequireExplicitIDs(t *testing.T) {
+	if explicitAdmin.ID() != 10 || explicitUser.ID() != 20 {
+		t.Errorf("unexpected IDs %d and %d", explicitAdmin.ID(), explicitUser.ID())
+	}
+
+	if explicitGuest.ID() != hashID[explicitRole]("Guest") {
+		t.Errorf("expected hash ID, got %d", explicitGuest.ID())
+	}
+
+	expectPanic(t, func() {
+		New[explicitRole]("Other")
+	})
+
+	if _, err := LoadDefinitions[explicitRole]([]byte(`[{"name": "Other"}]`)); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	NewWithID[explicitRole]("Other", 30)
+
+	type auto int
+
+	New[auto]("A")
+
+	expectPanic(t, RequireExplicitIDs[auto])
+}
@@ -0,0 +1,107 @@
+// Package featureflag gates enum values behind runtime feature flags, so new
+// values can be rolled out gradually. Gated values are hidden from listings
+// returned by Values and rejected by Check until their flag is enabled by the
+// configured Provider.
+package featureflag
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/bruno-ga/enum"
+	"golang.org/x/exp/constraints"
+)
+
+// Provider reports whether feature flags are enabled.
+type Provider interface {
+	Enabled(ctx context.Context, flag string) bool
+}
+
+// ProviderFunc adapts a function to the Provider interface.
+type ProviderFunc func(ctx context.Context, flag string) bool
+
+// Enabled implements the Provider interface.
+func (f ProviderFunc) Enabled(ctx context.Context, flag string) bool {
+	return f(ctx, flag)
+}
+
+var (
+	mu       sync.RWMutex
+	provider Provider
+
+	// flags maps gated values (stored as enum.Enum[T]) to their flags.
+	flags = make(map[any]string)
+)
+
+// SetProvider sets the Provider used to evaluate flags. Until a Provider is
+// set, all gated values are disabled.
+func SetProvider(p Provider) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	provider = p
+}
+
+// Gate makes the given value depend on the given flag. It is usually called
+// from an init function, right after the value is declared. This panics if
+// the value is already gated.
+func Gate[E enum.EnumType[T], T constraints.Integer](value E, flag string) {
+	e := enum.Enum[T](value)
+	if !e.Valid() {
+		panic("enum not initialized")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := flags[e]; ok {
+		panic(fmt.Sprintf("enum %s is already gated", e.Name()))
+	}
+
+	flags[e] = flag
+}
+
+// Flag returns the flag the given value depends on and true, or false if the
+// value is not gated.
+func Flag[E enum.EnumType[T], T constraints.Integer](value E) (string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	flag, ok := flags[enum.Enum[T](value)]
+
+	return flag, ok
+}
+
+// Enabled returns true if the given value is not gated or if its flag is
+// enabled for the given context.
+func Enabled[E enum.EnumType[T], T constraints.Integer](ctx context.Context, value E) bool {
+	mu.RLock()
+	flag, ok := flags[enum.Enum[T](value)]
+	p := provider
+	mu.RUnlock()
+
+	if !ok {
+		return true
+	}
+
+	return p != nil && p.Enabled(ctx, flag)
+}
+
+// Check returns a non-nil error if the given value is not enabled for the
+// given context. It is used to reject values decoded from inputs.
+func Check[E enum.EnumType[T], T constraints.Integer](ctx context.Context, value E) error {
+	if !Enabled(ctx, value) {
+		return fmt.Errorf("enum %s is not enabled", enum.Enum[T](value).Name())
+	}
+
+	return nil
+}
+
+// Values returns all enums associated with type T (as returned by
+// enum.EnumsByType) that are enabled for the given context.
+func Values[T constraints.Integer](ctx context.Context) []enum.Enum[T] {
+	return enum.Where(func(e enum.Enum[T]) bool {
+		return Enabled(ctx, e)
+	})
+}
@@ -0,0 +1,81 @@
+package featureflag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bruno-ga/enum"
+)
+
+type plan int
+
+type planEnum enum.Enum[plan]
+
+var (
+	free       = planEnum(enum.New[plan]("Free"))
+	pro        = planEnum(enum.New[plan]("Pro"))
+	enterprise = planEnum(enum.New[plan]("Enterprise"))
+)
+
+func init() {
+	Gate(enterprise, "enterprise-plan")
+}
+
+type betaKey struct{}
+
+func TestEnabled(t *testing.T) {
+	defer SetProvider(nil)
+
+	ctx := context.Background()
+	beta := context.WithValue(ctx, betaKey{}, true)
+
+	if !Enabled(ctx, free) || Enabled(ctx, enterprise) {
+		t.Errorf("expected only ungated values to be enabled without a provider")
+	}
+
+	SetProvider(ProviderFunc(func(ctx context.Context, flag string) bool {
+		return flag == "enterprise-plan" && ctx.Value(betaKey{}) != nil
+	}))
+
+	if Enabled(ctx, enterprise) {
+		t.Errorf("expected %s to be disabled", enterprise)
+	}
+
+	if !Enabled(beta, enterprise) {
+		t.Errorf("expected %s to be enabled", enterprise)
+	}
+
+	if err := Check(ctx, enterprise); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if err := Check(beta, pro); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if values := Values[plan](ctx); len(values) != 2 {
+		t.Errorf("expected 2 values, got %v", values)
+	}
+
+	if values := Values[plan](beta); len(values) != 3 {
+		t.Errorf("expected 3 values, got %v", values)
+	}
+}
+
+func TestFlag(t *testing.T) {
+	if flag, ok := Flag(enterprise); !ok || flag != "enterprise-plan" {
+		t.Errorf("expected enterprise-plan, got %q", flag)
+	}
+
+	if _, ok := Flag(free); ok {
+		t.Errorf("expected %s not to be gated", free)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+
+	Gate(enterprise, "other")
+}
@@ -0,0 +1,39 @@
+package enum
+
+import "golang.org/x/exp/constraints"
+
+// Freeze prevents any other enums associated with type T from being created
+// with New, which panics after this is called. It is usually called once all
+// enums of a type are declared (for example, from an init function or at the
+// start of main). Unrecognized enums of open types can still be added.
+//
+// Freezing also makes decoding faster: the names of the enums are indexed in
+// a collision-free hash table that is read without locking, so hot parsing
+// paths (EnumByTypeAndName, UnmarshalText, UnmarshalJSON, ...) do not pay for
+// a map lookup.
+func Freeze[T constraints.Integer]() {
+	getOrCreateSetForType[T]().Freeze()
+	emit[T](EventFrozen, nil)
+}
+
+// Frozen returns true if Freeze was called for type T.
+func Frozen[T constraints.Integer]() bool {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return false
+	}
+
+	return s.Frozen()
+}
+
+// RejectLateRegistration makes New panic for type T if any enum of that type
+// was already looked up by name or ID (including when decoding) or marshaled.
+// This is a weaker, automatic form of Freeze: registering enums after they
+// started being used usually indicates an initialization order bug that would
+// otherwise surface as intermittent unknown value errors.
+//
+// It is usually called from an init function of the package that declares the
+// enums, before any other package can use them.
+func RejectLateRegistration[T constraints.Integer]() {
+	getOrCreateSetForType[T]().RejectLateRegistration()
+}
@@ -0,0 +1,96 @@
+package enum
+
+import "testing"
+
+func expectPanic(t *testing.T, f func()) {
+	t.Helper()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic, got normal execution")
+		}
+	}()
+
+	f()
+}
+
+func TestFreeze(t *testing.T) {
+	type freezeStatus int
+
+	New[freezeStatus]("Active")
+
+	if Frozen[freezeStatus]() {
+		t.Errorf("expected type to not be frozen")
+	}
+
+	Freeze[freezeStatus]()
+
+	if !Frozen[freezeStatus]() {
+		t.Errorf("expected type to be frozen")
+	}
+
+	expectPanic(t, func() {
+		New[freezeStatus]("Inactive")
+	})
+
+	// Open types can still track unrecognized enums.
+	Open[freezeStatus]()
+
+	e, err := EnumByTypeAndName[freezeStatus]("Inactive")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !e.Unrecognized() {
+		t.Errorf("expected %s to be unrecognized", e)
+	}
+}
+
+func TestRejectLateRegistration(t *testing.T) {
+	type lateStatus int
+
+	RejectLateRegistration[lateStatus]()
+
+	active := New[lateStatus]("Active")
+	New[lateStatus]("Inactive")
+
+	if _, err := active.MarshalText(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectPanic(t, func() {
+		New[lateStatus]("Pending")
+	})
+}
+
+func TestRejectLateRegistration_Lookup(t *testing.T) {
+	type lateStatus int
+
+	RejectLateRegistration[lateStatus]()
+
+	New[lateStatus]("Active")
+
+	if _, err := EnumByTypeAndName[lateStatus]("Missing"); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	expectPanic(t, func() {
+		New[lateStatus]("Missing")
+	})
+}
+
+func TestLateRegistration_Allowed(t *testing.T) {
+	if debugMode {
+		t.Skip("late registration is rejected with the enumdebug build tag")
+	}
+
+	type lateStatus int
+
+	active := New[lateStatus]("Active")
+
+	if _, err := active.MarshalText(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Allowed by default.
+	New[lateStatus]("Inactive")
+}
@@ -0,0 +1,52 @@
+package enum
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// gapPolicy is the policy for gaps in the IDs of the enums of a type.
+type gapPolicy int
+
+const (
+	gapsUnspecified gapPolicy = iota
+	gapsForbidden
+	gapsAllowed
+)
+
+// RequireContiguous makes the registration of enums associated with type T
+// panic if their IDs would not be contiguous (for types whose IDs are used to
+// index arrays, see Array). As enums are checked as they are registered, IDs
+// set explicitly (with NewWithID, for example) must be declared in order.
+// Retired enums still count as registered, so retiring an enum does not
+// leave a gap.
+//
+// It is usually called from an init function of the package that declares the
+// enums. It panics if the IDs of already registered enums are not contiguous
+// or if AllowGaps was called for type T.
+func RequireContiguous[T constraints.Integer]() {
+	if err := getOrCreateSetForType[T]().SetGapPolicy(gapsForbidden); err != nil {
+		panic(err.Error())
+	}
+}
+
+// AllowGaps declares that the IDs of enums associated with type T are sparse
+// (for example, codes defined by an external system). Creating an Array for
+// type T then panics even if the IDs happen to be contiguous, instead of
+// failing later when a value is added. It panics if RequireContiguous was
+// called for type T.
+func AllowGaps[T constraints.Integer]() {
+	if err := getOrCreateSetForType[T]().SetGapPolicy(gapsAllowed); err != nil {
+		panic(err.Error())
+	}
+}
+
+// checkDense panics if gaps are allowed in the IDs of enums associated with
+// type T.
+func checkDense[T constraints.Integer]() {
+	s, err := getSetForType[T]()
+	if err == nil && s.GapPolicy() == gapsAllowed {
+		panic(fmt.Sprintf("ids of enums for type %s are sparse (see AllowGaps)", getTypeName[T]()))
+	}
+}
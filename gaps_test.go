@@ -0,0 +1,61 @@
+package enum
+
+import "testing"
+
+func TestRequireContiguous(t *testing.T) {
+	if debugMode {
+		t.Skip("late registration is rejected with the enumdebug build tag")
+	}
+
+	type dense int
+
+	RequireContiguous[dense]()
+
+	first := New[dense]("First")
+	NewWithID[dense]("Second", 1)
+	NewWithID[dense]("Sentinel", -1)
+
+	expectPanic(t, func() {
+		NewWithID[dense]("Far", 10)
+	})
+
+	if _, err := EnumByTypeAndName[dense]("Far"); err == nil {
+		t.Errorf("expected enum not to be registered")
+	}
+
+	if e := New[dense]("Third"); e.ID() != 2 {
+		t.Errorf("expected ID 2, got %d", e.ID())
+	}
+
+	Retire(first, New[dense]("Fourth"))
+
+	expectPanic(t, func() {
+		AllowGaps[dense]()
+	})
+
+	type sparse int
+
+	NewWithID[sparse]("A", 0)
+	NewWithID[sparse]("B", 2)
+
+	expectPanic(t, func() {
+		RequireContiguous[sparse]()
+	})
+}
+
+func TestAllowGaps(t *testing.T) {
+	type sparse int
+
+	AllowGaps[sparse]()
+
+	New[sparse]("A")
+	New[sparse]("B")
+
+	expectPanic(t, func() {
+		NewArray[sparse](1, 2)
+	})
+
+	expectPanic(t, func() {
+		RequireContiguous[sparse]()
+	})
+}
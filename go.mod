@@ -2,4 +2,9 @@ module github.com/bruno-ga/enum
 
 go 1.18
 
-require golang.org/x/exp v0.0.0-20220518171630-0b5c67f07fdf
+require (
+	golang.org/x/exp v0.0.0-20220518171630-0b5c67f07fdf
+	golang.org/x/text v0.21.0
+	golang.org/x/time v0.6.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,178 @@
+package enum
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// handshakePrefix identifies the format of handshake tokens.
+const handshakePrefix = "enum1:"
+
+// TypeSelector selects the enums associated with a type T. It is returned by
+// TypeOf.
+type TypeSelector struct {
+	t reflect.Type
+}
+
+// TypeOf returns a TypeSelector for the enums associated with type T.
+func TypeOf[T constraints.Integer]() TypeSelector {
+	return TypeSelector{getType[T]()}
+}
+
+// fingerprinter is implemented by all sets.
+type fingerprinter interface {
+	fingerprint() string
+}
+
+// HandshakeToken returns a token summarizing the enums (names, IDs and
+// retirements) of the given types, or of all types with registered enums if
+// none are given. It is intended to be exchanged when a connection between
+// services is set up and checked with VerifyHandshakeToken, so binaries built
+// with different enum definitions fail fast instead of silently corrupting
+// data. Unrecognized enums are not included.
+func HandshakeToken(types ...TypeSelector) string {
+	fingerprints := handshakeFingerprints(types)
+
+	entries := make([]string, 0, len(fingerprints))
+	for name, fingerprint := range fingerprints {
+		entries = append(entries, name+"="+fingerprint)
+	}
+
+	sort.Strings(entries)
+
+	return handshakePrefix + strings.Join(entries, ",")
+}
+
+// VerifyHandshakeToken checks a token returned by HandshakeToken in another
+// binary against the enums of the given types (or of all types with
+// registered enums if none are given). This returns a non-nil error naming
+// the types whose enums differ or that are missing from the token. Types
+// only present in the token are ignored.
+func VerifyHandshakeToken(token string, types ...TypeSelector) error {
+	if !strings.HasPrefix(token, handshakePrefix) {
+		return fmt.Errorf("invalid handshake token")
+	}
+
+	body := strings.TrimPrefix(token, handshakePrefix)
+
+	peer := make(map[string]string)
+	if body != "" {
+		for _, entry := range strings.Split(body, ",") {
+			name, fingerprint, ok := strings.Cut(entry, "=")
+			if !ok || name == "" {
+				return fmt.Errorf("invalid handshake token entry %q", entry)
+			}
+
+			peer[name] = fingerprint
+		}
+	}
+
+	var problems []string
+	for name, fingerprint := range handshakeFingerprints(types) {
+		switch other, ok := peer[name]; {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("%s missing from peer", name))
+		case other != fingerprint:
+			problems = append(problems, fmt.Sprintf("%s differs from peer", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+
+		return fmt.Errorf("enum definitions do not match: %s", strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+// handshakeFingerprints returns the fingerprints of the sets of the given
+// types by type name (including its package path). Selected types without a
+// set have the fingerprint of an empty set. The fingerprints of distinct
+// types with the same name (declared in functions) are combined.
+func handshakeFingerprints(types []TypeSelector) map[string]string {
+	setByTypeMu.RLock()
+	defer setByTypeMu.RUnlock()
+
+	byName := make(map[string][]string)
+	seen := make(map[reflect.Type]bool)
+
+	add := func(t reflect.Type, fingerprint string) {
+		if seen[t] {
+			return
+		}
+
+		seen[t] = true
+		name := t.PkgPath() + "." + t.Name()
+		byName[name] = append(byName[name], fingerprint)
+	}
+
+	if len(types) == 0 {
+		for t, s := range setByType {
+			if fingerprint := s.(fingerprinter).fingerprint(); fingerprint != "" {
+				add(t, fingerprint)
+			}
+		}
+	}
+
+	for _, selector := range types {
+		fingerprint := ""
+		if s, ok := setByType[selector.t]; ok {
+			fingerprint = s.(fingerprinter).fingerprint()
+		}
+
+		if fingerprint == "" {
+			fingerprint = emptyFingerprint
+		}
+
+		add(selector.t, fingerprint)
+	}
+
+	fingerprints := make(map[string]string, len(byName))
+	for name, list := range byName {
+		sort.Strings(list)
+		fingerprints[name] = strings.Join(list, "+")
+	}
+
+	return fingerprints
+}
+
+// emptyFingerprint is the fingerprint of a set without registered enums.
+var emptyFingerprint = hex.EncodeToString(make([]byte, 8))
+
+// fingerprint returns a (truncated) SHA-256 hash of the registered enums in
+// ID order or an empty string if there are none.
+func (s *internalSet[T]) fingerprint() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.enums) == 0 {
+		return ""
+	}
+
+	enums := make([]*internalEnum[T], len(s.enums))
+	copy(enums, s.enums)
+
+	sort.Slice(enums, func(i, j int) bool {
+		return enums[i].id < enums[j].id
+	})
+
+	h := sha256.New()
+	for _, e := range enums {
+		fmt.Fprintf(h, "%d %q", e.id, e.name)
+
+		if r, ok := s.replacements[e]; ok {
+			fmt.Fprintf(h, " > %d", r.id)
+		}
+
+		fmt.Fprintln(h)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
@@ -0,0 +1,51 @@
+package enum
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHandshakeToken(t *testing.T) {
+	token := HandshakeToken(TypeOf[Role](), TypeOf[Permission]())
+
+	if !strings.HasPrefix(token, "enum1:github.com/bruno-ga/enum.Permission=") || !strings.Contains(token, ",github.com/bruno-ga/enum.Role=") {
+		t.Errorf("unexpected token %s", token)
+	}
+
+	if token != HandshakeToken(TypeOf[Permission](), TypeOf[Role]()) {
+		t.Errorf("expected token to not depend on the order of types")
+	}
+
+	if err := VerifyHandshakeToken(token, TypeOf[Role]()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := VerifyHandshakeToken(HandshakeToken()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestVerifyHandshakeToken(t *testing.T) {
+	type handshakeStatus int
+
+	New[handshakeStatus]("Active")
+	before := HandshakeToken(TypeOf[handshakeStatus](), TypeOf[Role]())
+
+	New[handshakeStatus]("Inactive")
+
+	err := VerifyHandshakeToken(before, TypeOf[handshakeStatus](), TypeOf[Role]())
+	if err == nil || !strings.Contains(err.Error(), "enum.handshakeStatus differs from peer") {
+		t.Errorf("expected mismatch error, got %v", err)
+	}
+
+	err = VerifyHandshakeToken(HandshakeToken(TypeOf[Role]()), TypeOf[Permission]())
+	if err == nil || !strings.Contains(err.Error(), "github.com/bruno-ga/enum.Permission missing from peer") {
+		t.Errorf("expected missing error, got %v", err)
+	}
+
+	for _, token := range []string{"", "Role=00", "enum1:Role"} {
+		if err := VerifyHandshakeToken(token); err == nil {
+			t.Errorf("expected error for %q, got nil", token)
+		}
+	}
+}
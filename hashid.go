@@ -0,0 +1,27 @@
+package enum
+
+import (
+	"hash/fnv"
+
+	"golang.org/x/exp/constraints"
+)
+
+// HashID makes the ID of the Enum be derived from its name (using the FNV-1a
+// hash truncated to the size of type T) instead of its registration order, so
+// adding, removing or reordering other enums never changes it. New panics if
+// the ID is already used by another enum of the same type, which is more
+// likely the smaller type T is. Auto-generated IDs of other enums skip hash
+// IDs registered before them.
+func HashID() Option {
+	return func(a *attributes) {
+		a.hashID = true
+	}
+}
+
+// hashID returns the ID derived from the given name.
+func hashID[T constraints.Integer](name string) T {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+
+	return T(h.Sum64())
+}
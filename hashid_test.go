@@ -0,0 +1,50 @@
+package enum
+
+import "testing"
+
+func TestHashID(t *testing.T) {
+	type hashed uint32
+
+	a := New[hashed]("Alpha", HashID())
+	b := New[hashed]("Beta", HashID())
+	c := New[hashed]("Gamma")
+
+	if a.ID() != hashID[hashed]("Alpha") || b.ID() != hashID[hashed]("Beta") {
+		t.Errorf("expected IDs derived from names, got %d and %d", a.ID(), b.ID())
+	}
+
+	if a.ID() == b.ID() {
+		t.Errorf("expected different IDs")
+	}
+
+	if c.ID() != 0 {
+		t.Errorf("expected auto-generated ID 0, got %d", c.ID())
+	}
+
+	// The same name always has the same ID, regardless of the type.
+	type other uint32
+
+	if id := New[other]("Alpha", HashID()).ID(); uint32(id) != uint32(a.ID()) {
+		t.Errorf("expected ID %d, got %d", a.ID(), id)
+	}
+}
+
+func TestHashID_Collision(t *testing.T) {
+	type tiny uint8
+
+	// Find two names whose hashes collide for uint8.
+	names := make(map[tiny]string)
+	for i := 0; ; i++ {
+		name := string(rune('a'+i%26)) + string(rune('a'+i/26))
+		if other, ok := names[hashID[tiny](name)]; ok {
+			New[tiny](other, HashID())
+			expectPanic(t, func() {
+				New[tiny](name, HashID())
+			})
+
+			return
+		}
+
+		names[hashID[tiny](name)] = name
+	}
+}
@@ -0,0 +1,78 @@
+package enum
+
+import "golang.org/x/exp/constraints"
+
+// IdentityMetadata marks the given metadata keys of the enums associated with
+// type T as part of their identity, so EquivalentTo only considers two values
+// equivalent if their metadata for these keys is the same. It is intended for
+// types whose values carry metadata that is not part of their identity (and
+// may change, for example when it is reloaded at runtime) along with metadata
+// that is (like a version or a scope):
+//
+//	func init() {
+//		enum.IdentityMetadata[Plan]("billing_version")
+//	}
+//
+// The metadata for these keys can not be changed with UpdateMetadata. Calling
+// it again adds to the keys already marked.
+func IdentityMetadata[T constraints.Integer](keys ...string) {
+	getOrCreateSetForType[T]().AddIdentityKeys(keys)
+}
+
+// EquivalentTo returns true if this Enum and the given one are equal (see
+// Equal) and have the same metadata for the keys marked with
+// IdentityMetadata. Other metadata is not compared, so values holding
+// different versions of it are still equivalent. As identity metadata can not
+// be updated, values sharing the registered state are equivalent if they are
+// equal: this only differs from Equal for values produced by deep copy
+// libraries (see Canonical) with stale identity metadata. Without keys
+// marked, this is the same as Equal.
+func (e internalEnumWrapper[T]) EquivalentTo(other Member[T]) bool {
+	if !e.Equal(other) {
+		return false
+	}
+
+	if other == nil || !e.Valid() {
+		return true
+	}
+
+	s, err := getSetForType[T]()
+	if err != nil {
+		return true
+	}
+
+	o := other.wrapper()
+
+	for _, key := range s.IdentityKeys() {
+		value, ok := e.MetadataValue(key)
+		otherValue, otherOK := o.MetadataValue(key)
+
+		if ok != otherOK || value != otherValue {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AddIdentityKeys marks the given metadata keys as part of the identity of
+// the enums in the set. Keys already marked are ignored.
+func (s *internalSet[T]) AddIdentityKeys(keys []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		if !contains(s.identityKeys, key) {
+			s.identityKeys = append(s.identityKeys, key)
+		}
+	}
+}
+
+// IdentityKeys returns a copy of the metadata keys that are part of the
+// identity of the enums in the set.
+func (s *internalSet[T]) IdentityKeys() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]string(nil), s.identityKeys...)
+}
@@ -0,0 +1,56 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEquivalentTo(t *testing.T) {
+	type plan int
+
+	pro := New[plan]("Pro", Metadata("billing_version", "2"), Metadata("description", "For teams"))
+	free := New[plan]("Free")
+
+	IdentityMetadata[plan]("billing_version")
+
+	held := pro
+
+	if err := UpdateMetadata(pro, Metadata("description", "For growing teams")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var decoded Enum[plan]
+	if err := json.Unmarshal([]byte(`"Pro"`), &decoded); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, e := range []Enum[plan]{held, decoded} {
+		if !pro.EquivalentTo(e) || !e.EquivalentTo(pro) {
+			t.Errorf("expected %s to be equivalent after updating other metadata", e)
+		}
+	}
+
+	if err := UpdateMetadata(pro, Metadata("billing_version", "3")); err == nil {
+		t.Errorf("expected error updating identity metadata, got nil")
+	}
+
+	if err := UpdateMetadata(pro, Metadata("billing_version", "3"), Metadata("description", "For teams")); err == nil {
+		t.Errorf("expected error updating identity metadata, got nil")
+	}
+
+	if version, _ := pro.MetadataValue("billing_version"); version != "2" {
+		t.Errorf("expected identity metadata not to change, got %s", version)
+	}
+
+	if description, _ := pro.MetadataValue("description"); description != "For growing teams" {
+		t.Errorf("expected a rejected update to change nothing, got %s", description)
+	}
+
+	if err := UpdateMetadata(free, Metadata("billing_version", "1")); err == nil {
+		t.Errorf("expected error adding identity metadata, got nil")
+	}
+
+	if pro.EquivalentTo(free) || free.EquivalentTo(nil) || !(Enum[plan]{}).EquivalentTo(nil) {
+		t.Errorf("unexpected equivalence")
+	}
+}
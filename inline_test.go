@@ -0,0 +1,35 @@
+package enum
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"testing"
+)
+
+// TestInlining builds testdata/inline with -gcflags=-m and checks that the
+// compiler inlines the calls to ID, Name and String outside of this package.
+func TestInlining(t *testing.T) {
+	if testing.Short() {
+		t.Skip("building with the go command is slow")
+	}
+
+	gocmd := filepath.Join(runtime.GOROOT(), "bin", "go")
+	if _, err := os.Stat(gocmd); err != nil {
+		t.Skipf("go command not available: %s", err)
+	}
+
+	out, err := exec.Command(gocmd, "build", "-gcflags=-m", "-o", os.DevNull, "./testdata/inline").CombinedOutput()
+	if err != nil {
+		t.Fatalf("unexpected error: %s\n%s", err, out)
+	}
+
+	for _, method := range []string{"ID", "Name", "String"} {
+		re := regexp.MustCompile(`main\.go:\d+:\d+: inlining call to enum\.internalEnumWrapper\[[^\]]+\]\.` + method + `\n`)
+		if !re.Match(out) {
+			t.Errorf("expected the call to %s to be inlined, got:\n%s", method, out)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package enum
+
+import "sync"
+
+// Names of registered enums are interned so types sharing common names (such
+// as "Unknown") share the storage of these names. This matters for large
+// registries built from reference data at run time. Unrecognized names are
+// never interned as they may come from untrusted inputs.
+var (
+	internMu sync.Mutex
+	interned = make(map[string]string)
+)
+
+// intern returns the canonical instance of the given name.
+func intern(name string) string {
+	internMu.Lock()
+	defer internMu.Unlock()
+
+	if s, ok := interned[name]; ok {
+		return s
+	}
+
+	interned[name] = name
+
+	return name
+}
@@ -0,0 +1,26 @@
+package enum
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestIntern(t *testing.T) {
+	type first int
+	type second int
+
+	// Build names at run time so they do not share storage already.
+	a := New[first](strings.Repeat("Shared", 2))
+	b := New[second](strings.Repeat("Shared", 2))
+
+	nameA, nameB := a.Name(), b.Name()
+	if stringData(nameA) != stringData(nameB) {
+		t.Errorf("expected names to share storage")
+	}
+}
+
+func stringData(s string) uintptr {
+	return (*reflect.StringHeader)(unsafe.Pointer(&s)).Data
+}
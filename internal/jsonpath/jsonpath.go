@@ -0,0 +1,180 @@
+// Package jsonpath implements the lookup of struct fields by JSON object key
+// and JSON Pointers (RFC 6901) to report the paths of values in JSON
+// documents.
+package jsonpath
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Field returns the field of struct type t that encoding/json uses for the
+// given object key, including fields of embedded structs, or false if there is
+// none. As with encoding/json, a field with the exact name of the key is
+// preferred over one that only matches it case-insensitively, and fields with
+// the same name are resolved by depth first, then by the presence of a JSON tag.
+func Field(t reflect.Type, key string) (reflect.StructField, bool) {
+	var fold *field
+
+	fields := fields(t)
+	for i := range fields {
+		if fields[i].name == key {
+			return fields[i].StructField, true
+		}
+
+		if fold == nil && strings.EqualFold(fields[i].name, key) {
+			fold = &fields[i]
+		}
+	}
+
+	if fold != nil {
+		return fold.StructField, true
+	}
+
+	return reflect.StructField{}, false
+}
+
+// field is a field of a struct or of one of its embedded structs.
+type field struct {
+	reflect.StructField
+
+	name   string
+	tagged bool
+
+	// index is the index sequence of the field from the outer struct.
+	index []int
+}
+
+// fields returns the fields of struct type t that encoding/json uses, in the
+// order of their index sequences.
+func fields(t reflect.Type) []field {
+	type embedded struct {
+		t     reflect.Type
+		index []int
+	}
+
+	var all []field
+
+	visited := map[reflect.Type]bool{}
+	next := []embedded{{t: t}}
+
+	for len(next) > 0 {
+		current := next
+		next = nil
+
+		for _, e := range current {
+			// Types embedded more than once at the same depth are expanded
+			// each time, so that their fields are ambiguous.
+			if visited[e.t] {
+				continue
+			}
+
+			for i := 0; i < e.t.NumField(); i++ {
+				f := e.t.Field(i)
+
+				tag := f.Tag.Get("json")
+				if tag == "-" {
+					continue
+				}
+
+				name, _, _ := strings.Cut(tag, ",")
+				index := append(append([]int(nil), e.index...), i)
+
+				if f.Anonymous && name == "" {
+					ft := f.Type
+					if ft.Kind() == reflect.Pointer {
+						ft = ft.Elem()
+					}
+
+					if ft.Kind() == reflect.Struct {
+						next = append(next, embedded{t: ft, index: index})
+						continue
+					}
+				}
+
+				if !f.IsExported() {
+					continue
+				}
+
+				all = append(all, field{StructField: f, name: name, tagged: name != "", index: index})
+				if name == "" {
+					all[len(all)-1].name = f.Name
+				}
+			}
+		}
+
+		for _, e := range current {
+			visited[e.t] = true
+		}
+	}
+
+	// Fields are collected by depth, so the first fields with a given name
+	// are the shallowest ones.
+	byName := make(map[string][]field, len(all))
+	for _, f := range all {
+		byName[f.name] = append(byName[f.name], f)
+	}
+
+	dominant := make([]field, 0, len(byName))
+	for _, candidates := range byName {
+		if f, ok := dominantField(candidates); ok {
+			dominant = append(dominant, f)
+		}
+	}
+
+	sort.Slice(dominant, func(i, j int) bool {
+		a, b := dominant[i].index, dominant[j].index
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+
+		return len(a) < len(b)
+	})
+
+	return dominant
+}
+
+// dominantField returns the field that encoding/json uses among fields with
+// the same name, sorted by depth, or false if they are ambiguous.
+func dominantField(fields []field) (field, bool) {
+	depth := len(fields[0].index)
+
+	var dominant []field
+	for _, f := range fields {
+		if len(f.index) > depth {
+			break
+		}
+
+		dominant = append(dominant, f)
+	}
+
+	if len(dominant) == 1 {
+		return dominant[0], true
+	}
+
+	var tagged []field
+	for _, f := range dominant {
+		if f.tagged {
+			tagged = append(tagged, f)
+		}
+	}
+
+	if len(tagged) == 1 {
+		return tagged[0], true
+	}
+
+	return field{}, false
+}
+
+// Escape escapes the given reference token of a JSON Pointer.
+func Escape(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// Unescape reverses Escape.
+func Unescape(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
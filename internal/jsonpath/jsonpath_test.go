@@ -0,0 +1,102 @@
+package jsonpath
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestField(t *testing.T) {
+	type Inner struct {
+		Name   string
+		ID     int `json:"id"`
+		Hidden int
+		Tagged int `json:"tagged"`
+	}
+
+	type Other struct {
+		Hidden int
+		Tagged int
+	}
+
+	type Outer struct {
+		*Inner
+		Other
+
+		Kind  string `json:"kind,omitempty"`
+		Name  string
+		Skip  int `json:"-"`
+		Upper int `json:"UPPER"`
+		upper int
+	}
+
+	// Fields are identified by their types and their indexes in the structs
+	// declaring them.
+	tests := []struct {
+		key   string
+		owner reflect.Type
+		index int
+		ok    bool
+	}{
+		{"kind", reflect.TypeOf(Outer{}), 2, true},
+		{"Kind", reflect.TypeOf(Outer{}), 2, true},
+		{"Name", reflect.TypeOf(Outer{}), 3, true},
+		{"id", reflect.TypeOf(Inner{}), 1, true},
+		{"tagged", reflect.TypeOf(Inner{}), 3, true},
+		{"UPPER", reflect.TypeOf(Outer{}), 5, true},
+		{"upper", reflect.TypeOf(Outer{}), 5, true},
+		{"Hidden", nil, 0, false},
+		{"Skip", nil, 0, false},
+		{"missing", nil, 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			f, ok := Field(reflect.TypeOf(Outer{}), test.key)
+			if ok != test.ok {
+				t.Fatalf("expected %t, got %t", test.ok, ok)
+			}
+
+			if ok && !reflect.DeepEqual(f, test.owner.Field(test.index)) {
+				t.Errorf("expected field %s of %s, got %s", test.owner.Field(test.index).Name, test.owner, f.Name)
+			}
+		})
+	}
+}
+
+func TestField_OuterShadowsEmbedded(t *testing.T) {
+	type Embedded struct {
+		Value string `json:"value"`
+	}
+
+	type Outer struct {
+		Embedded
+
+		Value int `json:"value"`
+	}
+
+	f, ok := Field(reflect.TypeOf(Outer{}), "value")
+	if !ok {
+		t.Fatalf("expected field to be found")
+	}
+
+	if f.Type.Kind() != reflect.Int {
+		t.Errorf("expected the outer field, got one of type %s", f.Type)
+	}
+}
+
+func TestEscape(t *testing.T) {
+	for token, escaped := range map[string]string{
+		"a":    "a",
+		"a/b":  "a~1b",
+		"m~n":  "m~0n",
+		"~1/~": "~01~1~0",
+	} {
+		if actual := Escape(token); actual != escaped {
+			t.Errorf("expected %q, got %q", escaped, actual)
+		}
+
+		if actual := Unescape(escaped); actual != token {
+			t.Errorf("expected %q, got %q", token, actual)
+		}
+	}
+}
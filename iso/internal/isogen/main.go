@@ -0,0 +1,200 @@
+// Command isogen generates the data files of the ISO reference packages from
+// the CLDR data in golang.org/x/text. It is run through go:generate
+// directives in each package:
+//
+//	isogen -kind country -output countries.go
+//
+// Kinds are country (ISO 3166-1), currency (ISO 4217) and language
+// (ISO 639-1).
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// withdrawn holds codes that golang.org/x/text reports as countries but that
+// were withdrawn from ISO 3166-1 (they are listed in ISO 3166-3).
+var withdrawn = map[string]bool{
+	"AN": true, "BU": true, "CS": true, "CT": true, "DD": true, "DY": true,
+	"FX": true, "HV": true, "JT": true, "MI": true, "NH": true, "NQ": true,
+	"NT": true, "PC": true, "PU": true, "PZ": true, "RH": true, "SU": true,
+	"TP": true, "UK": true, "VD": true, "WK": true, "YD": true, "YU": true,
+	"ZR": true,
+}
+
+// deprecatedLanguages holds codes that golang.org/x/text accepts as languages
+// but that were withdrawn from ISO 639-1 or replaced by other codes.
+var deprecatedLanguages = map[string]bool{
+	"bh": true, "in": true, "iw": true, "ji": true, "jw": true, "mo": true,
+	"sh": true,
+}
+
+// entry is an enum to generate.
+type entry struct {
+	Code string
+	Args string
+}
+
+func main() {
+	kind := flag.String("kind", "", "kind of data: country, currency or language")
+	output := flag.String("output", "", "output file")
+	flag.Parse()
+
+	if *kind == "" || *output == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	src, err := generate(*kind)
+	if err == nil {
+		err = os.WriteFile(*output, src, 0o644)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "isogen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// generate returns the source of the data file for the given kind.
+func generate(kind string) ([]byte, error) {
+	var (
+		pkg     string
+		entries []entry
+	)
+
+	switch kind {
+	case "country":
+		pkg, entries = "iso3166", countries()
+	case "currency":
+		pkg, entries = "iso4217", currencies()
+	case "language":
+		pkg, entries = "iso639", languages()
+	default:
+		return nil, fmt.Errorf("unknown kind %q", kind)
+	}
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, struct {
+		Kind    string
+		Package string
+		Entries []entry
+	}{kind, pkg, entries}); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by isogen -kind {{.Kind}}; DO NOT EDIT.
+
+package {{.Package}}
+
+var (
+{{- range .Entries}}
+	{{.Code}} = register({{.Args}})
+{{- end}}
+)
+`))
+
+func countries() []entry {
+	var entries []entry
+
+	forEachCode(2, 'A', func(code string) {
+		r, err := language.ParseRegion(code)
+		if err != nil || r.String() != code || !r.IsCountry() || withdrawn[code] {
+			return
+		}
+
+		// User-assigned codes (like XK) have M49 codes of 900 and above.
+		if r.M49() == 0 || r.M49() >= 900 {
+			return
+		}
+
+		entries = append(entries, entry{
+			Code: code,
+			Args: fmt.Sprintf("%q, %q, %d, %+q", code, r.ISO3(), r.M49(), display.English.Regions().Name(r)),
+		})
+	})
+
+	return entries
+}
+
+func currencies() []entry {
+	tender := make(map[string]bool)
+	for it := currency.Query(); it.Next(); {
+		if it.IsTender() {
+			tender[it.Unit().String()] = true
+		}
+	}
+
+	codes := make([]string, 0, len(tender))
+	for code := range tender {
+		codes = append(codes, code)
+	}
+
+	sort.Strings(codes)
+
+	entries := make([]entry, 0, len(codes))
+	for _, code := range codes {
+		u := currency.MustParseISO(code)
+		scale, _ := currency.Standard.Rounding(u)
+
+		entries = append(entries, entry{
+			Code: code,
+			Args: fmt.Sprintf("%q, %+q, %+q, %d", code, fmt.Sprint(currency.Symbol(u)), fmt.Sprint(currency.NarrowSymbol(u)), scale),
+		})
+	}
+
+	return entries
+}
+
+func languages() []entry {
+	var entries []entry
+
+	forEachCode(2, 'a', func(code string) {
+		b, err := language.ParseBase(code)
+		if err != nil || b.String() != code || deprecatedLanguages[code] {
+			return
+		}
+
+		entries = append(entries, entry{
+			Code: strings.ToUpper(code),
+			Args: fmt.Sprintf("%q, %q, %+q", code, b.ISO3(), display.English.Languages().Name(b)),
+		})
+	})
+
+	return entries
+}
+
+// forEachCode calls f with every code of n letters starting at first (in
+// alphabetical order).
+func forEachCode(n int, first byte, f func(code string)) {
+	code := bytes.Repeat([]byte{first}, n)
+
+	for {
+		f(string(code))
+
+		i := n - 1
+		for ; i >= 0 && code[i] == first+25; i-- {
+			code[i] = first
+		}
+
+		if i < 0 {
+			return
+		}
+
+		code[i]++
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerate_UpToDate(t *testing.T) {
+	files := map[string]string{
+		"country":  "../../iso3166/countries.go",
+		"currency": "../../iso4217/currencies.go",
+		"language": "../../iso639/languages.go",
+	}
+
+	for kind, file := range files {
+		src, err := generate(kind)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		existing, err := os.ReadFile(filepath.FromSlash(file))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if !bytes.Equal(src, existing) {
+			t.Errorf("%s is out of date, run go generate", file)
+		}
+	}
+}
+
+func TestGenerate_UnknownKind(t *testing.T) {
+	if _, err := generate("planet"); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestForEachCode(t *testing.T) {
+	var codes []string
+	forEachCode(2, 'a', func(code string) {
+		codes = append(codes, code)
+	})
+
+	if len(codes) != 26*26 || codes[0] != "aa" || codes[1] != "ab" || codes[26] != "ba" || codes[len(codes)-1] != "zz" {
+		t.Errorf("unexpected codes %v", codes[:3])
+	}
+}
@@ -0,0 +1,255 @@
+// Code generated by isogen -kind country; DO NOT EDIT.
+
+package iso3166
+
+var (
+	AD = register("AD", "AND", 20, "Andorra")
+	AE = register("AE", "ARE", 784, "United Arab Emirates")
+	AF = register("AF", "AFG", 4, "Afghanistan")
+	AG = register("AG", "ATG", 28, "Antigua & Barbuda")
+	AI = register("AI", "AIA", 660, "Anguilla")
+	AL = register("AL", "ALB", 8, "Albania")
+	AM = register("AM", "ARM", 51, "Armenia")
+	AO = register("AO", "AGO", 24, "Angola")
+	AQ = register("AQ", "ATA", 10, "Antarctica")
+	AR = register("AR", "ARG", 32, "Argentina")
+	AS = register("AS", "ASM", 16, "American Samoa")
+	AT = register("AT", "AUT", 40, "Austria")
+	AU = register("AU", "AUS", 36, "Australia")
+	AW = register("AW", "ABW", 533, "Aruba")
+	AX = register("AX", "ALA", 248, "\u00c5land Islands")
+	AZ = register("AZ", "AZE", 31, "Azerbaijan")
+	BA = register("BA", "BIH", 70, "Bosnia & Herzegovina")
+	BB = register("BB", "BRB", 52, "Barbados")
+	BD = register("BD", "BGD", 50, "Bangladesh")
+	BE = register("BE", "BEL", 56, "Belgium")
+	BF = register("BF", "BFA", 854, "Burkina Faso")
+	BG = register("BG", "BGR", 100, "Bulgaria")
+	BH = register("BH", "BHR", 48, "Bahrain")
+	BI = register("BI", "BDI", 108, "Burundi")
+	BJ = register("BJ", "BEN", 204, "Benin")
+	BL = register("BL", "BLM", 652, "St. Barth\u00e9lemy")
+	BM = register("BM", "BMU", 60, "Bermuda")
+	BN = register("BN", "BRN", 96, "Brunei")
+	BO = register("BO", "BOL", 68, "Bolivia")
+	BQ = register("BQ", "BES", 535, "Caribbean Netherlands")
+	BR = register("BR", "BRA", 76, "Brazil")
+	BS = register("BS", "BHS", 44, "Bahamas")
+	BT = register("BT", "BTN", 64, "Bhutan")
+	BV = register("BV", "BVT", 74, "Bouvet Island")
+	BW = register("BW", "BWA", 72, "Botswana")
+	BY = register("BY", "BLR", 112, "Belarus")
+	BZ = register("BZ", "BLZ", 84, "Belize")
+	CA = register("CA", "CAN", 124, "Canada")
+	CC = register("CC", "CCK", 166, "Cocos (Keeling) Islands")
+	CD = register("CD", "COD", 180, "Congo - Kinshasa")
+	CF = register("CF", "CAF", 140, "Central African Republic")
+	CG = register("CG", "COG", 178, "Congo - Brazzaville")
+	CH = register("CH", "CHE", 756, "Switzerland")
+	CI = register("CI", "CIV", 384, "C\u00f4te d\u2019Ivoire")
+	CK = register("CK", "COK", 184, "Cook Islands")
+	CL = register("CL", "CHL", 152, "Chile")
+	CM = register("CM", "CMR", 120, "Cameroon")
+	CN = register("CN", "CHN", 156, "China")
+	CO = register("CO", "COL", 170, "Colombia")
+	CR = register("CR", "CRI", 188, "Costa Rica")
+	CU = register("CU", "CUB", 192, "Cuba")
+	CV = register("CV", "CPV", 132, "Cape Verde")
+	CW = register("CW", "CUW", 531, "Cura\u00e7ao")
+	CX = register("CX", "CXR", 162, "Christmas Island")
+	CY = register("CY", "CYP", 196, "Cyprus")
+	CZ = register("CZ", "CZE", 203, "Czechia")
+	DE = register("DE", "DEU", 276, "Germany")
+	DJ = register("DJ", "DJI", 262, "Djibouti")
+	DK = register("DK", "DNK", 208, "Denmark")
+	DM = register("DM", "DMA", 212, "Dominica")
+	DO = register("DO", "DOM", 214, "Dominican Republic")
+	DZ = register("DZ", "DZA", 12, "Algeria")
+	EC = register("EC", "ECU", 218, "Ecuador")
+	EE = register("EE", "EST", 233, "Estonia")
+	EG = register("EG", "EGY", 818, "Egypt")
+	EH = register("EH", "ESH", 732, "Western Sahara")
+	ER = register("ER", "ERI", 232, "Eritrea")
+	ES = register("ES", "ESP", 724, "Spain")
+	ET = register("ET", "ETH", 231, "Ethiopia")
+	FI = register("FI", "FIN", 246, "Finland")
+	FJ = register("FJ", "FJI", 242, "Fiji")
+	FK = register("FK", "FLK", 238, "Falkland Islands")
+	FM = register("FM", "FSM", 583, "Micronesia")
+	FO = register("FO", "FRO", 234, "Faroe Islands")
+	FR = register("FR", "FRA", 250, "France")
+	GA = register("GA", "GAB", 266, "Gabon")
+	GB = register("GB", "GBR", 826, "United Kingdom")
+	GD = register("GD", "GRD", 308, "Grenada")
+	GE = register("GE", "GEO", 268, "Georgia")
+	GF = register("GF", "GUF", 254, "French Guiana")
+	GG = register("GG", "GGY", 831, "Guernsey")
+	GH = register("GH", "GHA", 288, "Ghana")
+	GI = register("GI", "GIB", 292, "Gibraltar")
+	GL = register("GL", "GRL", 304, "Greenland")
+	GM = register("GM", "GMB", 270, "Gambia")
+	GN = register("GN", "GIN", 324, "Guinea")
+	GP = register("GP", "GLP", 312, "Guadeloupe")
+	GQ = register("GQ", "GNQ", 226, "Equatorial Guinea")
+	GR = register("GR", "GRC", 300, "Greece")
+	GS = register("GS", "SGS", 239, "South Georgia & South Sandwich Islands")
+	GT = register("GT", "GTM", 320, "Guatemala")
+	GU = register("GU", "GUM", 316, "Guam")
+	GW = register("GW", "GNB", 624, "Guinea-Bissau")
+	GY = register("GY", "GUY", 328, "Guyana")
+	HK = register("HK", "HKG", 344, "Hong Kong SAR China")
+	HM = register("HM", "HMD", 334, "Heard & McDonald Islands")
+	HN = register("HN", "HND", 340, "Honduras")
+	HR = register("HR", "HRV", 191, "Croatia")
+	HT = register("HT", "HTI", 332, "Haiti")
+	HU = register("HU", "HUN", 348, "Hungary")
+	ID = register("ID", "IDN", 360, "Indonesia")
+	IE = register("IE", "IRL", 372, "Ireland")
+	IL = register("IL", "ISR", 376, "Israel")
+	IM = register("IM", "IMN", 833, "Isle of Man")
+	IN = register("IN", "IND", 356, "India")
+	IO = register("IO", "IOT", 86, "British Indian Ocean Territory")
+	IQ = register("IQ", "IRQ", 368, "Iraq")
+	IR = register("IR", "IRN", 364, "Iran")
+	IS = register("IS", "ISL", 352, "Iceland")
+	IT = register("IT", "ITA", 380, "Italy")
+	JE = register("JE", "JEY", 832, "Jersey")
+	JM = register("JM", "JAM", 388, "Jamaica")
+	JO = register("JO", "JOR", 400, "Jordan")
+	JP = register("JP", "JPN", 392, "Japan")
+	KE = register("KE", "KEN", 404, "Kenya")
+	KG = register("KG", "KGZ", 417, "Kyrgyzstan")
+	KH = register("KH", "KHM", 116, "Cambodia")
+	KI = register("KI", "KIR", 296, "Kiribati")
+	KM = register("KM", "COM", 174, "Comoros")
+	KN = register("KN", "KNA", 659, "St. Kitts & Nevis")
+	KP = register("KP", "PRK", 408, "North Korea")
+	KR = register("KR", "KOR", 410, "South Korea")
+	KW = register("KW", "KWT", 414, "Kuwait")
+	KY = register("KY", "CYM", 136, "Cayman Islands")
+	KZ = register("KZ", "KAZ", 398, "Kazakhstan")
+	LA = register("LA", "LAO", 418, "Laos")
+	LB = register("LB", "LBN", 422, "Lebanon")
+	LC = register("LC", "LCA", 662, "St. Lucia")
+	LI = register("LI", "LIE", 438, "Liechtenstein")
+	LK = register("LK", "LKA", 144, "Sri Lanka")
+	LR = register("LR", "LBR", 430, "Liberia")
+	LS = register("LS", "LSO", 426, "Lesotho")
+	LT = register("LT", "LTU", 440, "Lithuania")
+	LU = register("LU", "LUX", 442, "Luxembourg")
+	LV = register("LV", "LVA", 428, "Latvia")
+	LY = register("LY", "LBY", 434, "Libya")
+	MA = register("MA", "MAR", 504, "Morocco")
+	MC = register("MC", "MCO", 492, "Monaco")
+	MD = register("MD", "MDA", 498, "Moldova")
+	ME = register("ME", "MNE", 499, "Montenegro")
+	MF = register("MF", "MAF", 663, "St. Martin")
+	MG = register("MG", "MDG", 450, "Madagascar")
+	MH = register("MH", "MHL", 584, "Marshall Islands")
+	MK = register("MK", "MKD", 807, "Macedonia")
+	ML = register("ML", "MLI", 466, "Mali")
+	MM = register("MM", "MMR", 104, "Myanmar (Burma)")
+	MN = register("MN", "MNG", 496, "Mongolia")
+	MO = register("MO", "MAC", 446, "Macau SAR China")
+	MP = register("MP", "MNP", 580, "Northern Mariana Islands")
+	MQ = register("MQ", "MTQ", 474, "Martinique")
+	MR = register("MR", "MRT", 478, "Mauritania")
+	MS = register("MS", "MSR", 500, "Montserrat")
+	MT = register("MT", "MLT", 470, "Malta")
+	MU = register("MU", "MUS", 480, "Mauritius")
+	MV = register("MV", "MDV", 462, "Maldives")
+	MW = register("MW", "MWI", 454, "Malawi")
+	MX = register("MX", "MEX", 484, "Mexico")
+	MY = register("MY", "MYS", 458, "Malaysia")
+	MZ = register("MZ", "MOZ", 508, "Mozambique")
+	NA = register("NA", "NAM", 516, "Namibia")
+	NC = register("NC", "NCL", 540, "New Caledonia")
+	NE = register("NE", "NER", 562, "Niger")
+	NF = register("NF", "NFK", 574, "Norfolk Island")
+	NG = register("NG", "NGA", 566, "Nigeria")
+	NI = register("NI", "NIC", 558, "Nicaragua")
+	NL = register("NL", "NLD", 528, "Netherlands")
+	NO = register("NO", "NOR", 578, "Norway")
+	NP = register("NP", "NPL", 524, "Nepal")
+	NR = register("NR", "NRU", 520, "Nauru")
+	NU = register("NU", "NIU", 570, "Niue")
+	NZ = register("NZ", "NZL", 554, "New Zealand")
+	OM = register("OM", "OMN", 512, "Oman")
+	PA = register("PA", "PAN", 591, "Panama")
+	PE = register("PE", "PER", 604, "Peru")
+	PF = register("PF", "PYF", 258, "French Polynesia")
+	PG = register("PG", "PNG", 598, "Papua New Guinea")
+	PH = register("PH", "PHL", 608, "Philippines")
+	PK = register("PK", "PAK", 586, "Pakistan")
+	PL = register("PL", "POL", 616, "Poland")
+	PM = register("PM", "SPM", 666, "St. Pierre & Miquelon")
+	PN = register("PN", "PCN", 612, "Pitcairn Islands")
+	PR = register("PR", "PRI", 630, "Puerto Rico")
+	PS = register("PS", "PSE", 275, "Palestinian Territories")
+	PT = register("PT", "PRT", 620, "Portugal")
+	PW = register("PW", "PLW", 585, "Palau")
+	PY = register("PY", "PRY", 600, "Paraguay")
+	QA = register("QA", "QAT", 634, "Qatar")
+	RE = register("RE", "REU", 638, "R\u00e9union")
+	RO = register("RO", "ROU", 642, "Romania")
+	RS = register("RS", "SRB", 688, "Serbia")
+	RU = register("RU", "RUS", 643, "Russia")
+	RW = register("RW", "RWA", 646, "Rwanda")
+	SA = register("SA", "SAU", 682, "Saudi Arabia")
+	SB = register("SB", "SLB", 90, "Solomon Islands")
+	SC = register("SC", "SYC", 690, "Seychelles")
+	SD = register("SD", "SDN", 729, "Sudan")
+	SE = register("SE", "SWE", 752, "Sweden")
+	SG = register("SG", "SGP", 702, "Singapore")
+	SH = register("SH", "SHN", 654, "St. Helena")
+	SI = register("SI", "SVN", 705, "Slovenia")
+	SJ = register("SJ", "SJM", 744, "Svalbard & Jan Mayen")
+	SK = register("SK", "SVK", 703, "Slovakia")
+	SL = register("SL", "SLE", 694, "Sierra Leone")
+	SM = register("SM", "SMR", 674, "San Marino")
+	SN = register("SN", "SEN", 686, "Senegal")
+	SO = register("SO", "SOM", 706, "Somalia")
+	SR = register("SR", "SUR", 740, "Suriname")
+	SS = register("SS", "SSD", 728, "South Sudan")
+	ST = register("ST", "STP", 678, "S\u00e3o Tom\u00e9 & Pr\u00edncipe")
+	SV = register("SV", "SLV", 222, "El Salvador")
+	SX = register("SX", "SXM", 534, "Sint Maarten")
+	SY = register("SY", "SYR", 760, "Syria")
+	SZ = register("SZ", "SWZ", 748, "Swaziland")
+	TC = register("TC", "TCA", 796, "Turks & Caicos Islands")
+	TD = register("TD", "TCD", 148, "Chad")
+	TF = register("TF", "ATF", 260, "French Southern Territories")
+	TG = register("TG", "TGO", 768, "Togo")
+	TH = register("TH", "THA", 764, "Thailand")
+	TJ = register("TJ", "TJK", 762, "Tajikistan")
+	TK = register("TK", "TKL", 772, "Tokelau")
+	TL = register("TL", "TLS", 626, "Timor-Leste")
+	TM = register("TM", "TKM", 795, "Turkmenistan")
+	TN = register("TN", "TUN", 788, "Tunisia")
+	TO = register("TO", "TON", 776, "Tonga")
+	TR = register("TR", "TUR", 792, "Turkey")
+	TT = register("TT", "TTO", 780, "Trinidad & Tobago")
+	TV = register("TV", "TUV", 798, "Tuvalu")
+	TW = register("TW", "TWN", 158, "Taiwan")
+	TZ = register("TZ", "TZA", 834, "Tanzania")
+	UA = register("UA", "UKR", 804, "Ukraine")
+	UG = register("UG", "UGA", 800, "Uganda")
+	UM = register("UM", "UMI", 581, "U.S. Outlying Islands")
+	US = register("US", "USA", 840, "United States")
+	UY = register("UY", "URY", 858, "Uruguay")
+	UZ = register("UZ", "UZB", 860, "Uzbekistan")
+	VA = register("VA", "VAT", 336, "Vatican City")
+	VC = register("VC", "VCT", 670, "St. Vincent & Grenadines")
+	VE = register("VE", "VEN", 862, "Venezuela")
+	VG = register("VG", "VGB", 92, "British Virgin Islands")
+	VI = register("VI", "VIR", 850, "U.S. Virgin Islands")
+	VN = register("VN", "VNM", 704, "Vietnam")
+	VU = register("VU", "VUT", 548, "Vanuatu")
+	WF = register("WF", "WLF", 876, "Wallis & Futuna")
+	WS = register("WS", "WSM", 882, "Samoa")
+	YE = register("YE", "YEM", 887, "Yemen")
+	YT = register("YT", "MYT", 175, "Mayotte")
+	ZA = register("ZA", "ZAF", 710, "South Africa")
+	ZM = register("ZM", "ZMB", 894, "Zambia")
+	ZW = register("ZW", "ZWE", 716, "Zimbabwe")
+)
@@ -0,0 +1,116 @@
+// Package iso3166 provides enums for the countries in ISO 3166-1, named after
+// their alpha-2 codes (for example, US) with their alpha-3 codes, numeric
+// codes and English names attached. The numeric codes are used as IDs and
+// the data is generated from the CLDR data in golang.org/x/text.
+//
+// Codes are matched case insensitively, including when decoding JSON, text or
+// SQL values.
+package iso3166
+
+//go:generate go run ../internal/isogen -kind country -output countries.go
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bruno-ga/enum"
+)
+
+// Code is the type associated with country enums. Its values are ISO 3166-1
+// numeric codes.
+type Code uint16
+
+// Country is an ISO 3166-1 country.
+type Country enum.Enum[Code]
+
+// Metadata keys holding the attached data of countries.
+const (
+	Alpha3Key  = "alpha3"
+	NumericKey = "numeric"
+	NameKey    = "name"
+)
+
+var (
+	setup    sync.Once
+	byAlpha3 = make(map[string]Country)
+)
+
+func register(alpha2, alpha3 string, numeric uint16, name string) Country {
+	setup.Do(func() {
+		enum.SetNormalizer[Code](strings.ToUpper)
+	})
+
+	c := Country(enum.NewWithID[Code](alpha2, Code(numeric),
+		enum.Metadata(Alpha3Key, alpha3),
+		enum.Metadata(NumericKey, strconv.Itoa(int(numeric))),
+		enum.Metadata(NameKey, name),
+	))
+
+	byAlpha3[alpha3] = c
+
+	return c
+}
+
+// Parse returns the country with the given alpha-2 code, ignoring case.
+func Parse(alpha2 string) (Country, error) {
+	e, err := enum.EnumByTypeAndName[Code](alpha2)
+
+	return Country(e), err
+}
+
+// ByAlpha3 returns the country with the given alpha-3 code (ignoring case)
+// and true, or false if there is none.
+func ByAlpha3(alpha3 string) (Country, bool) {
+	c, ok := byAlpha3[strings.ToUpper(alpha3)]
+
+	return c, ok
+}
+
+// ByNumeric returns the country with the given numeric code and true, or
+// false if there is none.
+func ByNumeric(numeric int) (Country, bool) {
+	if numeric < 0 || numeric > 999 {
+		return Country{}, false
+	}
+
+	e, err := enum.FromID(Code(numeric))
+
+	return Country(e), err == nil
+}
+
+// Values returns all countries sorted by numeric code.
+func Values() []Country {
+	enums := enum.EnumsByType[Code]()
+
+	countries := make([]Country, 0, len(enums))
+	for _, e := range enums {
+		countries = append(countries, Country(e))
+	}
+
+	return countries
+}
+
+// Alpha2 returns the alpha-2 code of this country, which is also its name.
+func (c Country) Alpha2() string {
+	return c.Name()
+}
+
+// Alpha3 returns the alpha-3 code of this country.
+func (c Country) Alpha3() string {
+	value, _ := c.MetadataValue(Alpha3Key)
+
+	return value
+}
+
+// Numeric returns the numeric code of this country, which is also its ID.
+func (c Country) Numeric() int {
+	return int(c.ID())
+}
+
+// EnglishName returns the English name of this country.
+func (c Country) EnglishName() string {
+	value, _ := c.MetadataValue(NameKey)
+
+	return value
+}
@@ -0,0 +1,43 @@
+package iso3166
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCountries(t *testing.T) {
+	if n := len(Values()); n != 249 {
+		t.Errorf("expected 249 countries, got %d", n)
+	}
+
+	if c, err := Parse("us"); err != nil || c != US {
+		t.Errorf("expected US, got %v (%v)", c, err)
+	}
+
+	if US.Alpha2() != "US" || US.Alpha3() != "USA" || US.Numeric() != 840 || US.EnglishName() != "United States" {
+		t.Errorf("unexpected data for US: %s %s %d %s", US.Alpha2(), US.Alpha3(), US.Numeric(), US.EnglishName())
+	}
+
+	if name := CI.EnglishName(); name != "C\u00f4te d\u2019Ivoire" {
+		t.Errorf("unexpected name %s", name)
+	}
+
+	if c, ok := ByAlpha3("deu"); !ok || c != DE {
+		t.Errorf("expected DE, got %v (%v)", c, ok)
+	}
+
+	if c, ok := ByNumeric(76); !ok || c != BR {
+		t.Errorf("expected BR, got %v (%v)", c, ok)
+	}
+
+	for _, n := range []int{-1, 999, 1000} {
+		if _, ok := ByNumeric(n); ok {
+			t.Errorf("expected no country for %d", n)
+		}
+	}
+
+	var v struct{ Country Country }
+	if err := json.Unmarshal([]byte(`{"Country":"fr"}`), &v); err != nil || v.Country != FR {
+		t.Errorf("expected FR, got %v (%v)", v.Country, err)
+	}
+}
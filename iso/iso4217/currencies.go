@@ -0,0 +1,161 @@
+// Code generated by isogen -kind currency; DO NOT EDIT.
+
+package iso4217
+
+var (
+	AED = register("AED", "AED", "AED", 2)
+	AFN = register("AFN", "AFN", "AFN", 0)
+	ALL = register("ALL", "ALL", "ALL", 0)
+	AMD = register("AMD", "AMD", "AMD", 0)
+	ANG = register("ANG", "ANG", "ANG", 2)
+	AOA = register("AOA", "AOA", "Kz", 2)
+	ARS = register("ARS", "ARS", "$", 2)
+	AUD = register("AUD", "A$", "$", 2)
+	AWG = register("AWG", "AWG", "AWG", 2)
+	AZN = register("AZN", "AZN", "AZN", 2)
+	BAM = register("BAM", "BAM", "KM", 2)
+	BBD = register("BBD", "BBD", "$", 2)
+	BDT = register("BDT", "BDT", "\u09f3", 2)
+	BGN = register("BGN", "BGN", "BGN", 2)
+	BHD = register("BHD", "BHD", "BHD", 3)
+	BIF = register("BIF", "BIF", "BIF", 0)
+	BMD = register("BMD", "BMD", "$", 2)
+	BND = register("BND", "BND", "$", 2)
+	BOB = register("BOB", "BOB", "Bs", 2)
+	BRL = register("BRL", "R$", "R$", 2)
+	BSD = register("BSD", "BSD", "$", 2)
+	BTN = register("BTN", "BTN", "BTN", 2)
+	BWP = register("BWP", "BWP", "P", 2)
+	BYN = register("BYN", "BYN", "\u0440.", 2)
+	BZD = register("BZD", "BZD", "$", 2)
+	CAD = register("CAD", "CA$", "$", 2)
+	CDF = register("CDF", "CDF", "CDF", 2)
+	CHF = register("CHF", "CHF", "CHF", 2)
+	CLP = register("CLP", "CLP", "$", 0)
+	CNY = register("CNY", "CN\u00a5", "\u00a5", 2)
+	COP = register("COP", "COP", "$", 0)
+	CRC = register("CRC", "CRC", "\u20a1", 2)
+	CUC = register("CUC", "CUC", "$", 2)
+	CUP = register("CUP", "CUP", "$", 2)
+	CVE = register("CVE", "CVE", "CVE", 2)
+	CZK = register("CZK", "CZK", "K\u010d", 2)
+	DJF = register("DJF", "DJF", "DJF", 0)
+	DKK = register("DKK", "DKK", "kr", 2)
+	DOP = register("DOP", "DOP", "$", 2)
+	DZD = register("DZD", "DZD", "DZD", 2)
+	EGP = register("EGP", "EGP", "E\u00a3", 2)
+	ERN = register("ERN", "ERN", "ERN", 2)
+	ETB = register("ETB", "ETB", "ETB", 2)
+	EUR = register("EUR", "\u20ac", "\u20ac", 2)
+	FJD = register("FJD", "FJD", "$", 2)
+	FKP = register("FKP", "FKP", "\u00a3", 2)
+	GBP = register("GBP", "\u00a3", "\u00a3", 2)
+	GEL = register("GEL", "GEL", "\u20be", 2)
+	GHS = register("GHS", "GHS", "GHS", 2)
+	GIP = register("GIP", "GIP", "\u00a3", 2)
+	GMD = register("GMD", "GMD", "GMD", 2)
+	GNF = register("GNF", "GNF", "FG", 0)
+	GTQ = register("GTQ", "GTQ", "Q", 2)
+	GYD = register("GYD", "GYD", "$", 0)
+	HKD = register("HKD", "HK$", "$", 2)
+	HNL = register("HNL", "HNL", "L", 2)
+	HRK = register("HRK", "HRK", "kn", 2)
+	HTG = register("HTG", "HTG", "HTG", 2)
+	HUF = register("HUF", "HUF", "Ft", 2)
+	IDR = register("IDR", "IDR", "Rp", 0)
+	ILS = register("ILS", "\u20aa", "\u20aa", 2)
+	INR = register("INR", "\u20b9", "\u20b9", 2)
+	IQD = register("IQD", "IQD", "IQD", 0)
+	IRR = register("IRR", "IRR", "IRR", 0)
+	ISK = register("ISK", "ISK", "kr", 0)
+	JMD = register("JMD", "JMD", "$", 2)
+	JOD = register("JOD", "JOD", "JOD", 3)
+	JPY = register("JPY", "JP\u00a5", "\u00a5", 0)
+	KES = register("KES", "KES", "KES", 2)
+	KGS = register("KGS", "KGS", "KGS", 2)
+	KHR = register("KHR", "KHR", "\u17db", 2)
+	KMF = register("KMF", "KMF", "CF", 0)
+	KPW = register("KPW", "KPW", "\u20a9", 0)
+	KRW = register("KRW", "\u20a9", "\u20a9", 0)
+	KWD = register("KWD", "KWD", "KWD", 3)
+	KYD = register("KYD", "KYD", "$", 2)
+	KZT = register("KZT", "KZT", "\u20b8", 2)
+	LAK = register("LAK", "LAK", "\u20ad", 0)
+	LBP = register("LBP", "LBP", "L\u00a3", 0)
+	LKR = register("LKR", "LKR", "Rs", 2)
+	LRD = register("LRD", "LRD", "$", 2)
+	LSL = register("LSL", "LSL", "LSL", 2)
+	LYD = register("LYD", "LYD", "LYD", 3)
+	MAD = register("MAD", "MAD", "MAD", 2)
+	MDL = register("MDL", "MDL", "MDL", 2)
+	MGA = register("MGA", "MGA", "Ar", 0)
+	MKD = register("MKD", "MKD", "MKD", 2)
+	MMK = register("MMK", "MMK", "K", 0)
+	MNT = register("MNT", "MNT", "\u20ae", 0)
+	MOP = register("MOP", "MOP", "MOP", 2)
+	MRO = register("MRO", "MRO", "MRO", 0)
+	MUR = register("MUR", "MUR", "Rs", 0)
+	MVR = register("MVR", "MVR", "MVR", 2)
+	MWK = register("MWK", "MWK", "MWK", 2)
+	MXN = register("MXN", "MX$", "$", 2)
+	MYR = register("MYR", "MYR", "RM", 2)
+	MZN = register("MZN", "MZN", "MZN", 2)
+	NAD = register("NAD", "NAD", "$", 2)
+	NGN = register("NGN", "NGN", "\u20a6", 2)
+	NIO = register("NIO", "NIO", "C$", 2)
+	NOK = register("NOK", "NOK", "kr", 2)
+	NPR = register("NPR", "NPR", "Rs", 2)
+	NZD = register("NZD", "NZ$", "$", 2)
+	OMR = register("OMR", "OMR", "OMR", 3)
+	PAB = register("PAB", "PAB", "PAB", 2)
+	PEN = register("PEN", "PEN", "PEN", 2)
+	PGK = register("PGK", "PGK", "PGK", 2)
+	PHP = register("PHP", "PHP", "\u20b1", 2)
+	PKR = register("PKR", "PKR", "Rs", 0)
+	PLN = register("PLN", "PLN", "z\u0142", 2)
+	PYG = register("PYG", "PYG", "\u20b2", 0)
+	QAR = register("QAR", "QAR", "QAR", 2)
+	RON = register("RON", "RON", "lei", 2)
+	RSD = register("RSD", "RSD", "RSD", 0)
+	RUB = register("RUB", "RUB", "\u20bd", 2)
+	RWF = register("RWF", "RWF", "RF", 0)
+	SAR = register("SAR", "SAR", "SAR", 2)
+	SBD = register("SBD", "SBD", "$", 2)
+	SCR = register("SCR", "SCR", "SCR", 2)
+	SDG = register("SDG", "SDG", "SDG", 2)
+	SEK = register("SEK", "SEK", "kr", 2)
+	SGD = register("SGD", "SGD", "$", 2)
+	SHP = register("SHP", "SHP", "\u00a3", 2)
+	SLL = register("SLL", "SLL", "SLL", 0)
+	SOS = register("SOS", "SOS", "SOS", 0)
+	SRD = register("SRD", "SRD", "$", 2)
+	SSP = register("SSP", "SSP", "\u00a3", 2)
+	STN = register("STN", "STN", "STN", 2)
+	SYP = register("SYP", "SYP", "\u00a3", 0)
+	SZL = register("SZL", "SZL", "SZL", 2)
+	THB = register("THB", "THB", "\u0e3f", 2)
+	TJS = register("TJS", "TJS", "TJS", 2)
+	TMT = register("TMT", "TMT", "TMT", 2)
+	TND = register("TND", "TND", "TND", 3)
+	TOP = register("TOP", "TOP", "T$", 2)
+	TRY = register("TRY", "TRY", "\u20ba", 2)
+	TTD = register("TTD", "TTD", "$", 2)
+	TWD = register("TWD", "NT$", "$", 2)
+	TZS = register("TZS", "TZS", "TZS", 0)
+	UAH = register("UAH", "UAH", "\u20b4", 2)
+	UGX = register("UGX", "UGX", "UGX", 0)
+	USD = register("USD", "US$", "$", 2)
+	UYU = register("UYU", "UYU", "$", 2)
+	UZS = register("UZS", "UZS", "UZS", 0)
+	VEF = register("VEF", "VEF", "Bs", 2)
+	VND = register("VND", "\u20ab", "\u20ab", 0)
+	VUV = register("VUV", "VUV", "VUV", 0)
+	WST = register("WST", "WST", "WST", 2)
+	XAF = register("XAF", "FCFA", "XAF", 0)
+	XCD = register("XCD", "EC$", "$", 2)
+	XOF = register("XOF", "CFA", "XOF", 0)
+	XPF = register("XPF", "CFPF", "XPF", 0)
+	YER = register("YER", "YER", "YER", 0)
+	ZAR = register("ZAR", "ZAR", "R", 2)
+	ZMW = register("ZMW", "ZMW", "ZK", 2)
+)
@@ -0,0 +1,93 @@
+// Package iso4217 provides enums for the ISO 4217 currencies that are legal
+// tender in at least one country, named after their codes (for example, USD)
+// with their symbols and minor units attached. The data is generated from the
+// CLDR data in golang.org/x/text.
+//
+// IDs are derived from the letters of the codes (so they are stable when
+// currencies are added or removed) and are not the ISO 4217 numeric codes.
+// Codes are matched case insensitively, including when decoding JSON, text or
+// SQL values.
+package iso4217
+
+//go:generate go run ../internal/isogen -kind currency -output currencies.go
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bruno-ga/enum"
+)
+
+// Code is the type associated with currency enums.
+type Code uint16
+
+// Currency is an ISO 4217 currency.
+type Currency enum.Enum[Code]
+
+// Metadata keys holding the attached data of currencies.
+const (
+	SymbolKey       = "symbol"
+	NarrowSymbolKey = "narrow_symbol"
+	MinorUnitsKey   = "minor_units"
+)
+
+var setup sync.Once
+
+func register(code, symbol, narrowSymbol string, minorUnits int) Currency {
+	setup.Do(func() {
+		enum.SetNormalizer[Code](strings.ToUpper)
+	})
+
+	id := Code(code[0]-'A')*26*26 + Code(code[1]-'A')*26 + Code(code[2]-'A')
+
+	return Currency(enum.NewWithID[Code](code, id,
+		enum.Metadata(SymbolKey, symbol),
+		enum.Metadata(NarrowSymbolKey, narrowSymbol),
+		enum.Metadata(MinorUnitsKey, strconv.Itoa(minorUnits)),
+	))
+}
+
+// Parse returns the currency with the given code, ignoring case.
+func Parse(code string) (Currency, error) {
+	e, err := enum.EnumByTypeAndName[Code](code)
+
+	return Currency(e), err
+}
+
+// Values returns all currencies sorted by code.
+func Values() []Currency {
+	enums := enum.EnumsByType[Code]()
+
+	currencies := make([]Currency, 0, len(enums))
+	for _, e := range enums {
+		currencies = append(currencies, Currency(e))
+	}
+
+	return currencies
+}
+
+// Symbol returns the English symbol of this currency, which is unambiguous
+// among currencies (for example, US$ for USD).
+func (c Currency) Symbol() string {
+	value, _ := c.MetadataValue(SymbolKey)
+
+	return value
+}
+
+// NarrowSymbol returns the narrow symbol of this currency, which may be
+// shared with other currencies (for example, $ for USD).
+func (c Currency) NarrowSymbol() string {
+	value, _ := c.MetadataValue(NarrowSymbolKey)
+
+	return value
+}
+
+// MinorUnits returns the number of decimal digits used for amounts of this
+// currency (for example, 2 for USD and 0 for JPY).
+func (c Currency) MinorUnits() int {
+	value, _ := c.MetadataValue(MinorUnitsKey)
+	n, _ := strconv.Atoi(value)
+
+	return n
+}
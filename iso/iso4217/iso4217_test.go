@@ -0,0 +1,25 @@
+package iso4217
+
+import "testing"
+
+func TestCurrencies(t *testing.T) {
+	if len(Values()) < 150 {
+		t.Errorf("expected at least 150 currencies, got %d", len(Values()))
+	}
+
+	if c, err := Parse("eur"); err != nil || c != EUR {
+		t.Errorf("expected EUR, got %v (%v)", c, err)
+	}
+
+	if USD.Symbol() != "US$" || USD.NarrowSymbol() != "$" || USD.MinorUnits() != 2 {
+		t.Errorf("unexpected data for USD: %s %s %d", USD.Symbol(), USD.NarrowSymbol(), USD.MinorUnits())
+	}
+
+	if JPY.MinorUnits() != 0 || GBP.Symbol() != "\u00a3" {
+		t.Errorf("unexpected data for JPY or GBP")
+	}
+
+	if id := USD.ID(); id != ('U'-'A')*26*26+('S'-'A')*26+('D'-'A') {
+		t.Errorf("unexpected id %d", id)
+	}
+}
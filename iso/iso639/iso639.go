@@ -0,0 +1,93 @@
+// Package iso639 provides enums for the languages in ISO 639-1, named after
+// their two-letter codes in upper case (for example, EN for "en") with their
+// three-letter codes and English names attached. The data is generated from
+// the CLDR data in golang.org/x/text.
+//
+// Names are the lower case codes, which are matched case insensitively,
+// including when decoding JSON, text or SQL values. IDs are derived from the
+// letters of the codes, so they are stable when languages are added.
+package iso639
+
+//go:generate go run ../internal/isogen -kind language -output languages.go
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/bruno-ga/enum"
+)
+
+// Code is the type associated with language enums.
+type Code uint16
+
+// Language is an ISO 639-1 language.
+type Language enum.Enum[Code]
+
+// Metadata keys holding the attached data of languages.
+const (
+	Alpha3Key = "alpha3"
+	NameKey   = "name"
+)
+
+var (
+	setup    sync.Once
+	byAlpha3 = make(map[string]Language)
+)
+
+func register(code, alpha3, name string) Language {
+	setup.Do(func() {
+		enum.SetNormalizer[Code](strings.ToLower)
+	})
+
+	id := Code(code[0]-'a')*26 + Code(code[1]-'a')
+
+	l := Language(enum.NewWithID[Code](code, id,
+		enum.Metadata(Alpha3Key, alpha3),
+		enum.Metadata(NameKey, name),
+	))
+
+	byAlpha3[alpha3] = l
+
+	return l
+}
+
+// Parse returns the language with the given two-letter code, ignoring case.
+func Parse(code string) (Language, error) {
+	e, err := enum.EnumByTypeAndName[Code](code)
+
+	return Language(e), err
+}
+
+// ByAlpha3 returns the language with the given three-letter (ISO 639-3) code,
+// ignoring case, and true, or false if there is none.
+func ByAlpha3(alpha3 string) (Language, bool) {
+	l, ok := byAlpha3[strings.ToLower(alpha3)]
+
+	return l, ok
+}
+
+// Values returns all languages sorted by code.
+func Values() []Language {
+	enums := enum.EnumsByType[Code]()
+
+	languages := make([]Language, 0, len(enums))
+	for _, e := range enums {
+		languages = append(languages, Language(e))
+	}
+
+	return languages
+}
+
+// Alpha3 returns the three-letter (ISO 639-3) code of this language.
+func (l Language) Alpha3() string {
+	value, _ := l.MetadataValue(Alpha3Key)
+
+	return value
+}
+
+// EnglishName returns the English name of this language.
+func (l Language) EnglishName() string {
+	value, _ := l.MetadataValue(NameKey)
+
+	return value
+}
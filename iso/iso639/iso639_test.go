@@ -0,0 +1,25 @@
+package iso639
+
+import "testing"
+
+func TestLanguages(t *testing.T) {
+	if n := len(Values()); n != 183 {
+		t.Errorf("expected 183 languages, got %d", n)
+	}
+
+	if l, err := Parse("PT"); err != nil || l != PT {
+		t.Errorf("expected pt, got %v (%v)", l, err)
+	}
+
+	if EN.Name() != "en" || EN.Alpha3() != "eng" || EN.EnglishName() != "English" {
+		t.Errorf("unexpected data for en: %s %s %s", EN.Name(), EN.Alpha3(), EN.EnglishName())
+	}
+
+	if l, ok := ByAlpha3("DEU"); !ok || l != DE {
+		t.Errorf("expected de, got %v (%v)", l, ok)
+	}
+
+	if _, err := Parse("iw"); err == nil {
+		t.Errorf("expected error for deprecated code, got nil")
+	}
+}
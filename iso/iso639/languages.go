@@ -0,0 +1,189 @@
+// Code generated by isogen -kind language; DO NOT EDIT.
+
+package iso639
+
+var (
+	AA = register("aa", "aar", "Afar")
+	AB = register("ab", "abk", "Abkhazian")
+	AE = register("ae", "ave", "Avestan")
+	AF = register("af", "afr", "Afrikaans")
+	AK = register("ak", "aka", "Akan")
+	AM = register("am", "amh", "Amharic")
+	AN = register("an", "arg", "Aragonese")
+	AR = register("ar", "ara", "Arabic")
+	AS = register("as", "asm", "Assamese")
+	AV = register("av", "ava", "Avaric")
+	AY = register("ay", "aym", "Aymara")
+	AZ = register("az", "aze", "Azerbaijani")
+	BA = register("ba", "bak", "Bashkir")
+	BE = register("be", "bel", "Belarusian")
+	BG = register("bg", "bul", "Bulgarian")
+	BI = register("bi", "bis", "Bislama")
+	BM = register("bm", "bam", "Bambara")
+	BN = register("bn", "ben", "Bangla")
+	BO = register("bo", "bod", "Tibetan")
+	BR = register("br", "bre", "Breton")
+	BS = register("bs", "bos", "Bosnian")
+	CA = register("ca", "cat", "Catalan")
+	CE = register("ce", "che", "Chechen")
+	CH = register("ch", "cha", "Chamorro")
+	CO = register("co", "cos", "Corsican")
+	CR = register("cr", "cre", "Cree")
+	CS = register("cs", "ces", "Czech")
+	CU = register("cu", "chu", "Church Slavic")
+	CV = register("cv", "chv", "Chuvash")
+	CY = register("cy", "cym", "Welsh")
+	DA = register("da", "dan", "Danish")
+	DE = register("de", "deu", "German")
+	DV = register("dv", "div", "Divehi")
+	DZ = register("dz", "dzo", "Dzongkha")
+	EE = register("ee", "ewe", "Ewe")
+	EL = register("el", "ell", "Greek")
+	EN = register("en", "eng", "English")
+	EO = register("eo", "epo", "Esperanto")
+	ES = register("es", "spa", "Spanish")
+	ET = register("et", "est", "Estonian")
+	EU = register("eu", "eus", "Basque")
+	FA = register("fa", "fas", "Persian")
+	FF = register("ff", "ful", "Fulah")
+	FI = register("fi", "fin", "Finnish")
+	FJ = register("fj", "fij", "Fijian")
+	FO = register("fo", "fao", "Faroese")
+	FR = register("fr", "fra", "French")
+	FY = register("fy", "fry", "Western Frisian")
+	GA = register("ga", "gle", "Irish")
+	GD = register("gd", "gla", "Scottish Gaelic")
+	GL = register("gl", "glg", "Galician")
+	GN = register("gn", "grn", "Guarani")
+	GU = register("gu", "guj", "Gujarati")
+	GV = register("gv", "glv", "Manx")
+	HA = register("ha", "hau", "Hausa")
+	HE = register("he", "heb", "Hebrew")
+	HI = register("hi", "hin", "Hindi")
+	HO = register("ho", "hmo", "Hiri Motu")
+	HR = register("hr", "hrv", "Croatian")
+	HT = register("ht", "hat", "Haitian Creole")
+	HU = register("hu", "hun", "Hungarian")
+	HY = register("hy", "hye", "Armenian")
+	HZ = register("hz", "her", "Herero")
+	IA = register("ia", "ina", "Interlingua")
+	ID = register("id", "ind", "Indonesian")
+	IE = register("ie", "ile", "Interlingue")
+	IG = register("ig", "ibo", "Igbo")
+	II = register("ii", "iii", "Sichuan Yi")
+	IK = register("ik", "ipk", "Inupiaq")
+	IO = register("io", "ido", "Ido")
+	IS = register("is", "isl", "Icelandic")
+	IT = register("it", "ita", "Italian")
+	IU = register("iu", "iku", "Inuktitut")
+	JA = register("ja", "jpn", "Japanese")
+	JV = register("jv", "jav", "Javanese")
+	KA = register("ka", "kat", "Georgian")
+	KG = register("kg", "kon", "Kongo")
+	KI = register("ki", "kik", "Kikuyu")
+	KJ = register("kj", "kua", "Kuanyama")
+	KK = register("kk", "kaz", "Kazakh")
+	KL = register("kl", "kal", "Kalaallisut")
+	KM = register("km", "khm", "Khmer")
+	KN = register("kn", "kan", "Kannada")
+	KO = register("ko", "kor", "Korean")
+	KR = register("kr", "kau", "Kanuri")
+	KS = register("ks", "kas", "Kashmiri")
+	KU = register("ku", "kur", "Kurdish")
+	KV = register("kv", "kom", "Komi")
+	KW = register("kw", "cor", "Cornish")
+	KY = register("ky", "kir", "Kyrgyz")
+	LA = register("la", "lat", "Latin")
+	LB = register("lb", "ltz", "Luxembourgish")
+	LG = register("lg", "lug", "Ganda")
+	LI = register("li", "lim", "Limburgish")
+	LN = register("ln", "lin", "Lingala")
+	LO = register("lo", "lao", "Lao")
+	LT = register("lt", "lit", "Lithuanian")
+	LU = register("lu", "lub", "Luba-Katanga")
+	LV = register("lv", "lav", "Latvian")
+	MG = register("mg", "mlg", "Malagasy")
+	MH = register("mh", "mah", "Marshallese")
+	MI = register("mi", "mri", "Maori")
+	MK = register("mk", "mkd", "Macedonian")
+	ML = register("ml", "mal", "Malayalam")
+	MN = register("mn", "mon", "Mongolian")
+	MR = register("mr", "mar", "Marathi")
+	MS = register("ms", "msa", "Malay")
+	MT = register("mt", "mlt", "Maltese")
+	MY = register("my", "mya", "Burmese")
+	NA = register("na", "nau", "Nauru")
+	NB = register("nb", "nob", "Norwegian Bokm\u00e5l")
+	ND = register("nd", "nde", "North Ndebele")
+	NE = register("ne", "nep", "Nepali")
+	NG = register("ng", "ndo", "Ndonga")
+	NL = register("nl", "nld", "Dutch")
+	NN = register("nn", "nno", "Norwegian Nynorsk")
+	NO = register("no", "nor", "Norwegian Bokm\u00e5l")
+	NR = register("nr", "nbl", "South Ndebele")
+	NV = register("nv", "nav", "Navajo")
+	NY = register("ny", "nya", "Nyanja")
+	OC = register("oc", "oci", "Occitan")
+	OJ = register("oj", "oji", "Ojibwa")
+	OM = register("om", "orm", "Oromo")
+	OR = register("or", "ori", "Odia")
+	OS = register("os", "oss", "Ossetic")
+	PA = register("pa", "pan", "Punjabi")
+	PI = register("pi", "pli", "Pali")
+	PL = register("pl", "pol", "Polish")
+	PS = register("ps", "pus", "Pashto")
+	PT = register("pt", "por", "Portuguese")
+	QU = register("qu", "que", "Quechua")
+	RM = register("rm", "roh", "Romansh")
+	RN = register("rn", "run", "Rundi")
+	RO = register("ro", "ron", "Romanian")
+	RU = register("ru", "rus", "Russian")
+	RW = register("rw", "kin", "Kinyarwanda")
+	SA = register("sa", "san", "Sanskrit")
+	SC = register("sc", "srd", "Sardinian")
+	SD = register("sd", "snd", "Sindhi")
+	SE = register("se", "sme", "Northern Sami")
+	SG = register("sg", "sag", "Sango")
+	SI = register("si", "sin", "Sinhala")
+	SK = register("sk", "slk", "Slovak")
+	SL = register("sl", "slv", "Slovenian")
+	SM = register("sm", "smo", "Samoan")
+	SN = register("sn", "sna", "Shona")
+	SO = register("so", "som", "Somali")
+	SQ = register("sq", "sqi", "Albanian")
+	SR = register("sr", "srp", "Serbian")
+	SS = register("ss", "ssw", "Swati")
+	ST = register("st", "sot", "Southern Sotho")
+	SU = register("su", "sun", "Sundanese")
+	SV = register("sv", "swe", "Swedish")
+	SW = register("sw", "swa", "Swahili")
+	TA = register("ta", "tam", "Tamil")
+	TE = register("te", "tel", "Telugu")
+	TG = register("tg", "tgk", "Tajik")
+	TH = register("th", "tha", "Thai")
+	TI = register("ti", "tir", "Tigrinya")
+	TK = register("tk", "tuk", "Turkmen")
+	TL = register("tl", "tgl", "Filipino")
+	TN = register("tn", "tsn", "Tswana")
+	TO = register("to", "ton", "Tongan")
+	TR = register("tr", "tur", "Turkish")
+	TS = register("ts", "tso", "Tsonga")
+	TT = register("tt", "tat", "Tatar")
+	TW = register("tw", "twi", "Akan")
+	TY = register("ty", "tah", "Tahitian")
+	UG = register("ug", "uig", "Uyghur")
+	UK = register("uk", "ukr", "Ukrainian")
+	UR = register("ur", "urd", "Urdu")
+	UZ = register("uz", "uzb", "Uzbek")
+	VE = register("ve", "ven", "Venda")
+	VI = register("vi", "vie", "Vietnamese")
+	VO = register("vo", "vol", "Volap\u00fck")
+	WA = register("wa", "wln", "Walloon")
+	WO = register("wo", "wol", "Wolof")
+	XH = register("xh", "xho", "Xhosa")
+	YI = register("yi", "yid", "Yiddish")
+	YO = register("yo", "yor", "Yoruba")
+	ZA = register("za", "zha", "Zhuang")
+	ZH = register("zh", "zho", "Chinese")
+	ZU = register("zu", "zul", "Zulu")
+)
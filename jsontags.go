@@ -0,0 +1,379 @@
+package enum
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/bruno-ga/enum/internal/jsonpath"
+)
+
+// TaggedJSON wraps a pointer to a struct so that encoding/json applies the
+// options in the enum struct tags of its Enum fields. It is returned by
+// Tagged.
+type TaggedJSON struct {
+	v any
+}
+
+// Tagged returns a wrapper for the given pointer to a struct that makes
+// encoding/json apply the options in the enum struct tags of its Enum fields
+// (including the fields of nested structs), so the handling of missing, null
+// and invalid values can differ per field without declaring new types:
+//
+//	type Request struct {
+//		Role  RoleEnum  `json:"role" enum:"default=Guest"`
+//		Scope ScopeEnum `json:"scope" enum:"strict"`
+//		Tier  TierEnum  `json:"tier" enum:"lenient"`
+//	}
+//
+//	err := json.Unmarshal(data, enum.Tagged(&req))
+//
+// The options, separated by commas, are:
+//
+//   - default=Name: missing, null and empty values are decoded to the enum
+//     with the given name. It is also marshaled instead of Enums that are not
+//     initialized.
+//   - strict: missing, null and empty values are errors (unless there is a
+//     default), as are unrecognized values of open types (see Open).
+//   - lenient: names are trimmed and matched case-insensitively and IDs are
+//     accepted as strings (as with HookLenient).
+//
+// Without default or strict, null leaves a tagged field unchanged, as it does
+// for other types in encoding/json. Fields without an enum tag are decoded as
+// usual. If any tagged value is invalid, the struct is not modified and a
+// FieldErrors is returned with paths in JSON Pointer (RFC 6901) format (see
+// DecodeJSON).
+func Tagged(ptr any) *TaggedJSON {
+	return &TaggedJSON{ptr}
+}
+
+// enumTagOptions are the options of an enum struct tag.
+type enumTagOptions struct {
+	def     string
+	strict  bool
+	lenient bool
+}
+
+// parseEnumTag returns the options of the given enum struct tag.
+func parseEnumTag(tag string) (enumTagOptions, error) {
+	var o enumTagOptions
+
+	for _, opt := range strings.Split(tag, ",") {
+		switch name, value, _ := strings.Cut(strings.TrimSpace(opt), "="); name {
+		case "default":
+			o.def = value
+		case "strict":
+			o.strict = true
+		case "lenient":
+			o.lenient = true
+		case "":
+		default:
+			return o, fmt.Errorf("unknown option %q in enum tag", name)
+		}
+	}
+
+	return o, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t *TaggedJSON) MarshalJSON() ([]byte, error) {
+	rv := reflect.ValueOf(t.v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return []byte("null"), nil
+		}
+
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return json.Marshal(t.v)
+	}
+
+	// Defaults are set on a copy so the wrapped struct is not modified.
+	cp := reflect.New(rv.Type())
+	cp.Elem().Set(rv)
+
+	if err := setTaggedDefaults(cp.Elem()); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(cp.Interface())
+}
+
+// setTaggedDefaults sets the Enum fields of the given struct that are not
+// initialized to the defaults in their enum struct tags.
+func setTaggedDefaults(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+
+		fv := v.Field(i)
+
+		if !isEnumType(f.Type) {
+			if f.Type.Kind() == reflect.Struct {
+				if err := setTaggedDefaults(fv); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		tag, ok := f.Tag.Lookup("enum")
+		if !ok || !fv.CanSet() || fv.Interface().(enumValidator).initialized() {
+			continue
+		}
+
+		o, err := parseEnumTag(tag)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.Name, err)
+		}
+
+		if o.def == "" {
+			continue
+		}
+
+		if err := fv.Addr().Interface().(enumDecoder).decodeName(o.def, false); err != nil {
+			return fmt.Errorf("field %s: invalid default: %w", f.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *TaggedJSON) UnmarshalJSON(data []byte) error {
+	rv := reflect.ValueOf(t.v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return json.Unmarshal(data, t.v)
+	}
+
+	var errs FieldErrors
+
+	data = rewriteTaggedJSON(rv.Elem().Type(), data, "", &errs)
+
+	if len(errs) > 0 {
+		sortFieldErrors(errs)
+
+		return errs
+	}
+
+	return json.Unmarshal(data, t.v)
+}
+
+// rewriteTaggedJSON returns the given JSON value for struct type t with the
+// values of its tagged Enum fields rewritten according to their options, so
+// they can be decoded by encoding/json. Invalid values are added to errs.
+func rewriteTaggedJSON(t reflect.Type, data []byte, path string, errs *FieldErrors) []byte {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil || obj == nil {
+		// Not an object: encoding/json reports the error.
+		return data
+	}
+
+	seen := make(map[string]bool, len(obj))
+	changed := false
+
+	for key, raw := range obj {
+		f, ok := jsonpath.Field(t, key)
+		if !ok {
+			continue
+		}
+
+		seen[jsonFieldName(f)] = true
+
+		ft := f.Type
+		if !isEnumType(ft) {
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+
+			if ft.Kind() == reflect.Struct && !isEnumType(ft) && !isJSONNull(raw) {
+				if rewritten := rewriteTaggedJSON(ft, raw, path+"/"+jsonpath.Escape(key), errs); !bytes.Equal(rewritten, raw) {
+					obj[key], changed = rewritten, true
+				}
+			}
+
+			continue
+		}
+
+		tag, ok := f.Tag.Lookup("enum")
+		if !ok {
+			continue
+		}
+
+		rewritten, keep := rewriteTaggedValue(ft, tag, raw, true, path+"/"+jsonpath.Escape(key), errs)
+		switch {
+		case !keep:
+			delete(obj, key)
+			changed = true
+		case !bytes.Equal(rewritten, raw):
+			obj[key], changed = rewritten, true
+		}
+	}
+
+	enums, structs := taggedFields(t)
+
+	for _, f := range enums {
+		name := jsonFieldName(f)
+		if seen[name] {
+			continue
+		}
+
+		if rewritten, keep := rewriteTaggedValue(f.Type, f.Tag.Get("enum"), nil, false, path+"/"+jsonpath.Escape(name), errs); keep {
+			obj[name], changed = rewritten, true
+		}
+	}
+
+	// Missing nested structs are rewritten as empty objects, so the options
+	// of their fields apply too.
+	for _, f := range structs {
+		name := jsonFieldName(f)
+		if seen[name] {
+			continue
+		}
+
+		if rewritten := rewriteTaggedJSON(f.Type, []byte("{}"), path+"/"+jsonpath.Escape(name), errs); string(rewritten) != "{}" {
+			obj[name], changed = rewritten, true
+		}
+	}
+
+	if !changed {
+		return data
+	}
+
+	rewritten, err := json.Marshal(obj)
+	if err != nil {
+		return data
+	}
+
+	return rewritten
+}
+
+// rewriteTaggedValue returns the given JSON value (or nil if not present) for
+// the Enum type t rewritten according to the given enum struct tag, and
+// whether it must be kept in the object.
+func rewriteTaggedValue(t reflect.Type, tag string, raw json.RawMessage, present bool, path string, errs *FieldErrors) (json.RawMessage, bool) {
+	fail := func(err error) (json.RawMessage, bool) {
+		*errs = append(*errs, &FieldError{path, err})
+
+		return raw, present
+	}
+
+	o, err := parseEnumTag(tag)
+	if err != nil {
+		return fail(err)
+	}
+
+	target := reflect.New(t)
+	d := target.Interface().(enumDecoder)
+
+	invalid := func(err error) (json.RawMessage, bool) {
+		input := "<missing>"
+		if present {
+			input = string(raw)
+		}
+
+		return fail(&InvalidValueError{Type: t, Input: input, Allowed: d.names(), Err: err})
+	}
+
+	var name string
+	isString := present && json.Unmarshal(raw, &name) == nil && !isJSONNull(raw)
+
+	if !present || isJSONNull(raw) || (isString && strings.TrimSpace(name) == "") {
+		switch {
+		case o.def != "":
+			if err := d.decodeName(o.def, false); err != nil {
+				return fail(fmt.Errorf("invalid default: %w", err))
+			}
+
+			return encodeTagged(target, fail)
+		case o.strict:
+			return invalid(fmt.Errorf("value required"))
+		case present && isJSONNull(raw):
+			return nil, false
+		}
+
+		return raw, present
+	}
+
+	if !isString {
+		return raw, true
+	}
+
+	if err := d.decodeName(name, o.lenient); err != nil {
+		return invalid(err)
+	}
+
+	if o.strict && target.Interface().(interface{ Unrecognized() bool }).Unrecognized() {
+		return invalid(fmt.Errorf("unrecognized value"))
+	}
+
+	return encodeTagged(target, fail)
+}
+
+// encodeTagged returns the JSON encoding of the Enum pointed to by target.
+func encodeTagged(target reflect.Value, fail func(error) (json.RawMessage, bool)) (json.RawMessage, bool) {
+	data, err := json.Marshal(target.Interface())
+	if err != nil {
+		return fail(err)
+	}
+
+	return data, true
+}
+
+// taggedFields returns the Enum fields of struct type t (including the fields
+// of embedded structs promoted by encoding/json) with an enum struct tag and
+// its other (not pointer) struct fields.
+func taggedFields(t reflect.Type) (enums, structs []reflect.StructField) {
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		if name, _, _ := strings.Cut(tag, ","); f.Anonymous && name == "" && !isEnumType(f.Type) {
+			if f.Type.Kind() == reflect.Struct {
+				embeddedEnums, embeddedStructs := taggedFields(f.Type)
+				enums = append(enums, embeddedEnums...)
+				structs = append(structs, embeddedStructs...)
+			}
+
+			continue
+		}
+
+		switch _, ok := f.Tag.Lookup("enum"); {
+		case !f.IsExported():
+		case isEnumType(f.Type):
+			if ok {
+				enums = append(enums, f)
+			}
+		case f.Type.Kind() == reflect.Struct:
+			structs = append(structs, f)
+		}
+	}
+
+	return enums, structs
+}
+
+// jsonFieldName returns the object key encoding/json uses for the given field.
+func jsonFieldName(f reflect.StructField) string {
+	if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" {
+		return name
+	}
+
+	return f.Name
+}
+
+func isJSONNull(raw json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+}
@@ -0,0 +1,142 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type taggedRequest struct {
+	Role       RoleEnum       `json:"role" enum:"default=Guest"`
+	Permission PermissionEnum `json:"permission" enum:"strict"`
+	Alt        RoleEnum       `json:"alt,omitempty" enum:"lenient"`
+	Keep       RoleEnum       `json:"keep" enum:""`
+	Plain      RoleEnum       `json:"plain"`
+	Nested     struct {
+		Role RoleEnum `json:"role" enum:"default=Admin"`
+	} `json:"nested"`
+}
+
+func TestTagged_Unmarshal(t *testing.T) {
+	var r taggedRequest
+	r.Keep = Admin
+
+	data := `{"role":null,"permission":"Read","alt":" guest ","keep":null,"plain":"User","nested":{}}`
+	if err := json.Unmarshal([]byte(data), Tagged(&r)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if r.Role != Guest || r.Permission != Read || r.Alt != Guest || r.Keep != Admin || r.Plain != User || r.Nested.Role != Admin {
+		t.Errorf("unexpected request %+v", r)
+	}
+
+	// Missing and empty values use the default.
+	r = taggedRequest{}
+	if err := json.Unmarshal([]byte(`{"role":"","permission":"Write","plain":"User"}`), Tagged(&r)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if r.Role != Guest || r.Permission != Write || r.Nested.Role != Admin {
+		t.Errorf("unexpected request %+v", r)
+	}
+}
+
+func TestTagged_UnmarshalErrors(t *testing.T) {
+	r := taggedRequest{Plain: Admin}
+
+	err := json.Unmarshal([]byte(`{"alt":"owner","plain":"User","nested":{"role":"Nobody"}}`), Tagged(&r))
+
+	var errs FieldErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected FieldErrors, got %v", err)
+	}
+
+	paths := make([]string, 0, len(errs))
+	for _, e := range errs {
+		paths = append(paths, e.Path)
+	}
+
+	if got := strings.Join(paths, " "); got != "/alt /nested/role /permission" {
+		t.Errorf("unexpected paths %s", got)
+	}
+
+	var invalid *InvalidValueError
+	if !errors.As(errs[2], &invalid) || invalid.Input != "<missing>" || !strings.Contains(invalid.Error(), "value required") {
+		t.Errorf("unexpected error %v", errs[2])
+	}
+
+	if r.Plain != Admin {
+		t.Errorf("expected the request not to be modified, got %+v", r)
+	}
+
+	if err := json.Unmarshal([]byte(`{"permission":null}`), Tagged(&r)); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	var bad struct {
+		Role RoleEnum `json:"role" enum:"default=Owner"`
+	}
+
+	if err := json.Unmarshal([]byte(`{}`), Tagged(&bad)); err == nil || !strings.Contains(err.Error(), "invalid default") {
+		t.Errorf("expected invalid default error, got %v", err)
+	}
+
+	var unknown struct {
+		Role RoleEnum `json:"role" enum:"required"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"role":"Admin"}`), Tagged(&unknown)); err == nil || !strings.Contains(err.Error(), `unknown option "required"`) {
+		t.Errorf("expected unknown option error, got %v", err)
+	}
+}
+
+func TestTagged_Strict(t *testing.T) {
+	type channel int
+
+	email := New[channel]("Email")
+	Open[channel]()
+
+	var v struct {
+		Strict Enum[channel] `json:"strict" enum:"strict"`
+		Open   Enum[channel] `json:"open" enum:""`
+	}
+
+	if err := json.Unmarshal([]byte(`{"strict":"Email","open":"Fax"}`), Tagged(&v)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v.Strict != email || !v.Open.Unrecognized() {
+		t.Errorf("unexpected value %+v", v)
+	}
+
+	if err := json.Unmarshal([]byte(`{"strict":"Pager"}`), Tagged(&v)); err == nil || !strings.Contains(err.Error(), "unrecognized value") {
+		t.Errorf("expected unrecognized value error, got %v", err)
+	}
+}
+
+func TestTagged_Marshal(t *testing.T) {
+	r := taggedRequest{Permission: Read, Alt: Admin, Keep: User, Plain: User}
+
+	data, err := json.Marshal(Tagged(&r))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"role":"Guest","permission":"Read","alt":"Admin","keep":"User","plain":"User","nested":{"role":"Admin"}}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+
+	if r.Role.Valid() {
+		t.Errorf("expected the request not to be modified, got %+v", r)
+	}
+
+	if _, err := json.Marshal(Tagged(&taggedRequest{})); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if data, err := json.Marshal(Tagged((*taggedRequest)(nil))); err != nil || string(data) != "null" {
+		t.Errorf("expected null, got %s (%v)", data, err)
+	}
+}
@@ -0,0 +1,38 @@
+package enum
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Lazy returns a function that returns the enum associated with type T and
+// the given name. The enum is only looked up on the first call (which is safe
+// to do concurrently) so the returned function can be assigned to package
+// variables without depending on the initialization order of the package that
+// registers the enum. This helps breaking initialization cycles when enums
+// and their consumers live in separate packages.
+//
+// The returned function panics if, by the time it is first called, there is
+// no enum associated with type T and the given name.
+func Lazy[T constraints.Integer](name string) func() Enum[T] {
+	var once sync.Once
+	var e Enum[T]
+	var err error
+
+	return func() Enum[T] {
+		once.Do(func() {
+			var ie *internalEnum[T]
+			if ie, err = getInternalEnumForName[T](name); err == nil {
+				e = Enum[T]{internalEnumWrapper[T]{ie}}
+			}
+		})
+
+		if err != nil {
+			panic(fmt.Sprintf("lazy enum: %s", err))
+		}
+
+		return e
+	}
+}
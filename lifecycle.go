@@ -0,0 +1,267 @@
+package enum
+
+import (
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Lifecycle is the stage of an enum in its lifecycle, from its introduction
+// to its removal from active use, for types whose values are rolled out
+// gradually (for example, API values that are experimental before they are
+// stable). Stages are ordered, so stage >= LifecycleDeprecated is true for
+// deprecated and retired enums.
+type Lifecycle int
+
+const (
+	// LifecycleExperimental is the stage of enums created with the
+	// Experimental option.
+	LifecycleExperimental Lifecycle = iota + 1
+
+	// LifecycleBeta is the stage of enums created with the Beta option.
+	LifecycleBeta
+
+	// LifecycleStable is the stage of enums created without a stage (the
+	// default).
+	LifecycleStable
+
+	// LifecycleDeprecated is the stage of enums created with the Deprecated
+	// option.
+	LifecycleDeprecated
+
+	// LifecycleRetired is the stage of enums retired with Retire.
+	LifecycleRetired
+)
+
+// String implements the fmt.Stringer interface.
+func (l Lifecycle) String() string {
+	switch l {
+	case LifecycleExperimental:
+		return "experimental"
+	case LifecycleBeta:
+		return "beta"
+	case LifecycleStable:
+		return "stable"
+	case LifecycleDeprecated:
+		return "deprecated"
+	case LifecycleRetired:
+		return "retired"
+	default:
+		return fmt.Sprintf("Lifecycle(%d)", int(l))
+	}
+}
+
+// Stage sets the lifecycle stage of the Enum. Stage(LifecycleDeprecated) is
+// the same as Deprecated. This panics if the stage is LifecycleRetired (enums
+// are retired with Retire, which needs a replacement) or not a valid stage.
+func Stage(stage Lifecycle) Option {
+	if stage < LifecycleExperimental || stage >= LifecycleRetired {
+		panic(fmt.Sprintf("invalid lifecycle stage %s for a new enum", stage))
+	}
+
+	return func(a *attributes) {
+		a.stage = stage
+		a.deprecated = stage == LifecycleDeprecated
+	}
+}
+
+// Experimental marks the Enum as experimental (see Lifecycle).
+func Experimental() Option {
+	return Stage(LifecycleExperimental)
+}
+
+// Beta marks the Enum as beta (see Lifecycle).
+func Beta() Option {
+	return Stage(LifecycleBeta)
+}
+
+// Lifecycle returns the lifecycle stage of this Enum: LifecycleRetired if it
+// was retired with Retire, LifecycleDeprecated if it was created with the
+// Deprecated option or the stage given with the Stage option otherwise (or
+// LifecycleStable if none).
+func (e internalEnumWrapper[T]) Lifecycle() Lifecycle {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if e.Retired() {
+		return LifecycleRetired
+	}
+
+	return e.internalEnum.stageAttr()
+}
+
+// stageAttr returns the stage of the enum given by its attributes (which is
+// never LifecycleRetired).
+func (e *internalEnum[T]) stageAttr() Lifecycle {
+	switch {
+	case e.deprecated:
+		return LifecycleDeprecated
+	case e.stage != 0:
+		return e.stage
+	default:
+		return LifecycleStable
+	}
+}
+
+// EnumsInLifecycle returns the enums associated with type T that are in any
+// of the given lifecycle stages, sorted by ID. Unlike EnumsByType, retired
+// enums are included if LifecycleRetired is given, so for example
+// EnumsInLifecycle[T](LifecycleStable) lists the values to document and
+// EnumsInLifecycle[T](LifecycleDeprecated, LifecycleRetired) the ones to
+// migrate away from.
+func EnumsInLifecycle[T constraints.Integer](stages ...Lifecycle) []Enum[T] {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return nil
+	}
+
+	internalEnums := s.InLifecycle(stages)
+	sort.Slice(internalEnums, func(i, j int) bool {
+		return internalEnums[i].id < internalEnums[j].id
+	})
+
+	enums := make([]Enum[T], 0, len(internalEnums))
+	for _, e := range internalEnums {
+		enums = append(enums, Enum[T]{internalEnumWrapper[T]{e}})
+	}
+
+	return enums
+}
+
+// lifecyclePolicy is the policy for decoding enums in a lifecycle stage.
+type lifecyclePolicy struct {
+	reject bool
+	warn   func(Value)
+}
+
+// The functions below set the policy for decoding enums associated with type
+// T in the given lifecycle stages, for rolling out new values (for example,
+// rejecting experimental values in production) and phasing out old ones. The
+// policy applies when decoding names or IDs from text, JSON, binary, SQL or
+// any other external input. Retired enums are checked before they are
+// resolved to their replacements, so rejecting LifecycleRetired makes their
+// names and IDs fail to decode instead of being replaced. Marshaling is never
+// affected (see RejectDeprecated for the equivalent policy for deprecated
+// enums). Each call replaces the previous policy of the given stages.
+
+// AcceptLifecycle makes enums associated with type T in the given lifecycle
+// stages be decoded like any other (the default).
+func AcceptLifecycle[T constraints.Integer](stages ...Lifecycle) {
+	getOrCreateSetForType[T]().SetLifecyclePolicy(stages, lifecyclePolicy{})
+}
+
+// WarnLifecycle makes decoding an enum associated with type T in any of the
+// given lifecycle stages call the given function with it (for example, to
+// count clients still sending deprecated values) before it is returned as
+// usual.
+func WarnLifecycle[T constraints.Integer](hook func(Value), stages ...Lifecycle) {
+	if hook == nil {
+		panic("nil lifecycle hook")
+	}
+
+	getOrCreateSetForType[T]().SetLifecyclePolicy(stages, lifecyclePolicy{warn: hook})
+}
+
+// RejectLifecycle makes decoding an enum associated with type T in any of
+// the given lifecycle stages fail, as if it was not registered.
+func RejectLifecycle[T constraints.Integer](stages ...Lifecycle) {
+	getOrCreateSetForType[T]().SetLifecyclePolicy(stages, lifecyclePolicy{reject: true})
+}
+
+// SetLifecyclePolicy sets the policy for decoding enums in the given stages.
+func (s *internalSet[T]) SetLifecyclePolicy(stages []Lifecycle, policy lifecyclePolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, stage := range stages {
+		if stage < LifecycleExperimental || stage > LifecycleRetired {
+			panic(fmt.Sprintf("invalid lifecycle stage %s", stage))
+		}
+
+		if !policy.reject && policy.warn == nil {
+			delete(s.lifecyclePolicies, stage)
+			continue
+		}
+
+		if s.lifecyclePolicies == nil {
+			s.lifecyclePolicies = make(map[Lifecycle]lifecyclePolicy)
+		}
+
+		s.lifecyclePolicies[stage] = policy
+	}
+
+	var enabled uint32
+	if len(s.lifecyclePolicies) > 0 {
+		enabled = 1
+	}
+
+	atomic.StoreUint32(&s.lifecycleEnabled, enabled)
+}
+
+// InLifecycle returns the registered enums in any of the given stages in
+// registration order. Unrecognized enums are not included.
+func (s *internalSet[T]) InLifecycle(stages []Lifecycle) []*internalEnum[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var enums []*internalEnum[T]
+	for _, e := range s.enums {
+		stage := s.stageLocked(e)
+		for _, st := range stages {
+			if st == stage {
+				enums = append(enums, e)
+				break
+			}
+		}
+	}
+
+	return enums
+}
+
+// stageLocked returns the lifecycle stage of the given enum. It must be called
+// with the lock held.
+func (s *internalSet[T]) stageLocked(e *internalEnum[T]) Lifecycle {
+	if _, ok := s.replacements[e]; ok {
+		return LifecycleRetired
+	}
+
+	return e.stageAttr()
+}
+
+// lifecycleDefaultLocked returns true if the given enum is decoded without a
+// warning or error by the lifecycle policy. It must be called with the lock
+// held.
+func (s *internalSet[T]) lifecycleDefaultLocked(e *internalEnum[T]) bool {
+	if atomic.LoadUint32(&s.lifecycleEnabled) == 0 || e.unrecognized {
+		return true
+	}
+
+	_, ok := s.lifecyclePolicies[s.stageLocked(e)]
+
+	return !ok
+}
+
+// CheckLifecycle applies the policy for decoding enums in the stage of the
+// given enum, returning a non-nil error if it must be rejected.
+func (s *internalSet[T]) CheckLifecycle(e *internalEnum[T]) error {
+	if atomic.LoadUint32(&s.lifecycleEnabled) == 0 || e.unrecognized {
+		return nil
+	}
+
+	s.mu.RLock()
+	stage := s.stageLocked(e)
+	policy := s.lifecyclePolicies[stage]
+	s.mu.RUnlock()
+
+	switch {
+	case policy.reject:
+		return fmt.Errorf("enum %s of type %s is %s and can not be decoded", e.name, getTypeName[T](), stage)
+	case policy.warn != nil:
+		policy.warn(Enum[T]{internalEnumWrapper[T]{e}})
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package enum
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestLifecycle(t *testing.T) {
+	type feature int
+
+	var (
+		preview  = New[feature]("Preview", Experimental())
+		search   = New[feature]("Search", Beta())
+		export   = New[feature]("Export")
+		legacy   = New[feature]("Legacy", Deprecated())
+		old      = New[feature]("Old", Stage(LifecycleDeprecated))
+		imported = New[feature]("Imported")
+	)
+
+	Retire(imported, export)
+
+	expected := map[Enum[feature]]Lifecycle{
+		preview:  LifecycleExperimental,
+		search:   LifecycleBeta,
+		export:   LifecycleStable,
+		legacy:   LifecycleDeprecated,
+		old:      LifecycleDeprecated,
+		imported: LifecycleRetired,
+	}
+
+	for e, stage := range expected {
+		if e.Lifecycle() != stage {
+			t.Errorf("expected %s to be %s, got %s", e, stage, e.Lifecycle())
+		}
+	}
+
+	if !old.Deprecated() || preview.Deprecated() {
+		t.Errorf("unexpected Deprecated")
+	}
+
+	if enums := EnumsInLifecycle[feature](LifecycleDeprecated, LifecycleRetired); !Equal(enums, []Enum[feature]{legacy, old, imported}) {
+		t.Errorf("unexpected deprecated and retired enums %v", enums)
+	}
+
+	if enums := EnumsInLifecycle[feature](LifecycleExperimental, LifecycleBeta); !Equal(enums, []Enum[feature]{preview, search}) {
+		t.Errorf("unexpected experimental and beta enums %v", enums)
+	}
+
+	if LifecycleBeta.String() != "beta" || Lifecycle(9).String() != "Lifecycle(9)" {
+		t.Errorf("unexpected String")
+	}
+
+	expectPanic(t, func() {
+		Stage(LifecycleRetired)
+	})
+
+	expectPanic(t, func() {
+		Stage(0)
+	})
+}
+
+func TestLifecyclePolicy(t *testing.T) {
+	type feature int
+
+	var (
+		preview  = New[feature]("Preview", Experimental())
+		export   = New[feature]("Export")
+		legacy   = New[feature]("Legacy", Deprecated())
+		imported = New[feature]("Imported")
+	)
+
+	Retire(imported, export)
+
+	RejectLifecycle[feature](LifecycleExperimental, LifecycleRetired)
+
+	var warned []string
+	WarnLifecycle[feature](func(v Value) {
+		warned = append(warned, v.Name())
+	}, LifecycleDeprecated)
+
+	for _, name := range []string{"Preview", "Imported"} {
+		if _, err := EnumByTypeAndName[feature](name); err == nil || !strings.Contains(err.Error(), "can not be decoded") {
+			t.Errorf("expected %s to be rejected, got %v", name, err)
+		}
+
+		var e Enum[feature]
+		if err := json.Unmarshal([]byte(`"`+name+`"`), &e); err == nil {
+			t.Errorf("expected %s to be rejected when unmarshaling", name)
+		}
+	}
+
+	if _, err := FromID(preview.ID()); err == nil {
+		t.Errorf("expected ID of %s to be rejected", preview)
+	}
+
+	if _, err := FromIDs([]feature{export.ID(), preview.ID()}); err == nil || !strings.Contains(err.Error(), "index 1") {
+		t.Errorf("expected rejection at index 1, got %v", err)
+	}
+
+	if _, err := ParseAll[feature]([]string{"Export", "Legacy", "Preview"}); err == nil || !strings.Contains(err.Error(), "index 2") {
+		t.Errorf("expected rejection at index 2, got %v", err)
+	}
+
+	if e, err := EnumByTypeAndName[feature]("Legacy"); err != nil || e != legacy {
+		t.Errorf("expected %s, got %v (%v)", legacy, e, err)
+	}
+
+	if e, err := FromIDs([]feature{export.ID(), legacy.ID()}); err != nil || !Equal(e, []Enum[feature]{export, legacy}) {
+		t.Errorf("unexpected enums %v (%v)", e, err)
+	}
+
+	if len(warned) != 3 || warned[0] != "Legacy" {
+		t.Errorf("expected Legacy to be warned 3 times, got %v", warned)
+	}
+
+	// Marshaling is not affected.
+	if data, err := json.Marshal(preview); err != nil || string(data) != `"Preview"` {
+		t.Errorf("unexpected JSON %s (%v)", data, err)
+	}
+
+	AcceptLifecycle[feature](LifecycleExperimental, LifecycleRetired, LifecycleDeprecated)
+
+	if e, err := EnumByTypeAndName[feature]("Imported"); err != nil || e != export {
+		t.Errorf("expected %s, got %v (%v)", export, e, err)
+	}
+
+	if e, err := EnumByTypeAndName[feature]("Preview"); err != nil || e != preview {
+		t.Errorf("expected %s, got %v (%v)", preview, e, err)
+	}
+
+	expectPanic(t, func() {
+		WarnLifecycle[feature](nil, LifecycleBeta)
+	})
+}
+
+func TestLifecyclePolicy_ProfileAndSnapshot(t *testing.T) {
+	type rollout int
+
+	newThing := New[rollout]("NewThing", Experimental())
+	current := New[rollout]("Current")
+	previous := New[rollout]("Previous")
+	Retire(previous, current)
+
+	SetProfile[rollout]("api", Profile{Rename: ToSnakeCase})
+
+	if e, err := UnmarshalTextProfile[rollout]("api", []byte("previous")); err != nil || e != current {
+		t.Errorf("expected %s, got %v (%v)", current, e, err)
+	}
+
+	if e, ok := Snapshot[rollout]().Parse("Previous"); !ok || e != current {
+		t.Errorf("expected %s, got %v", current, e)
+	}
+
+	view := Snapshot[rollout]()
+
+	RejectLifecycle[rollout](LifecycleExperimental)
+
+	if _, err := UnmarshalTextProfile[rollout]("api", []byte("new_thing")); err == nil {
+		t.Errorf("expected new_thing to be rejected")
+	}
+
+	for _, v := range []*TypeView[rollout]{view, Snapshot[rollout]()} {
+		if _, ok := v.Parse("NewThing"); ok {
+			t.Errorf("expected NewThing to be rejected")
+		}
+
+		if _, ok := v.FromID(newThing.ID()); ok {
+			t.Errorf("expected the ID of NewThing to be rejected")
+		}
+
+		if e, ok := v.Parse("Current"); !ok || e != current {
+			t.Errorf("expected %s, got %v", current, e)
+		}
+	}
+
+	if debugMode {
+		return
+	}
+
+	// Names added after the profile was used are found.
+	later := New[rollout]("LaterThing")
+
+	if e, err := UnmarshalTextProfile[rollout]("api", []byte("later_thing")); err != nil || e != later {
+		t.Errorf("expected %s, got %v (%v)", later, e, err)
+	}
+}
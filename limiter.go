@@ -0,0 +1,82 @@
+package enum
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+	"golang.org/x/time/rate"
+)
+
+// Limiter rate limits events by enum value, with a separate token bucket
+// (a rate.Limiter) for each enum associated with type T.
+//
+// Limiters are usually built during initialization (with NewLimiter and Set)
+// and are safe for concurrent use after that. Events for enums not returned by
+// EnumsByType when the Limiter is created (like unrecognized or retired ones)
+// share a single token bucket with the default limit.
+type Limiter[T constraints.Integer] struct {
+	limiters map[T]*rate.Limiter
+	other    *rate.Limiter
+}
+
+// NewLimiter returns a new Limiter where all enums associated with type T
+// allow events up to the given rate and bursts of at most the given size.
+func NewLimiter[T constraints.Integer](limit rate.Limit, burst int) *Limiter[T] {
+	enums := EnumsByType[T]()
+
+	l := &Limiter[T]{
+		limiters: make(map[T]*rate.Limiter, len(enums)),
+		other:    rate.NewLimiter(limit, burst),
+	}
+
+	for _, e := range enums {
+		l.limiters[e.ID()] = rate.NewLimiter(limit, burst)
+	}
+
+	return l
+}
+
+// Set changes the rate and burst size for the given enum and returns the
+// Limiter itself so calls can be chained. This panics if the enum is not
+// handled by the Limiter.
+func (l *Limiter[T]) Set(value Member[T], limit rate.Limit, burst int) *Limiter[T] {
+	w := value.wrapper()
+	if !w.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if _, ok := l.limiters[w.id]; !ok {
+		panic(fmt.Sprintf("enum %s is not handled by the limiter", w.name))
+	}
+
+	l.limiters[w.id] = rate.NewLimiter(limit, burst)
+
+	return l
+}
+
+// Allow reports whether an event for the given enum may happen now.
+func (l *Limiter[T]) Allow(value Member[T]) bool {
+	return l.Limiter(value).Allow()
+}
+
+// Wait blocks until an event for the given enum may happen, the context is
+// done or the wait would exceed the context deadline.
+func (l *Limiter[T]) Wait(ctx context.Context, value Member[T]) error {
+	return l.Limiter(value).Wait(ctx)
+}
+
+// Limiter returns the token bucket for the given enum, for the operations not
+// exposed by Limiter directly (like reservations).
+func (l *Limiter[T]) Limiter(value Member[T]) *rate.Limiter {
+	w := value.wrapper()
+	if !w.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if r, ok := l.limiters[w.id]; ok {
+		return r
+	}
+
+	return l.other
+}
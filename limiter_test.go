@@ -0,0 +1,58 @@
+package enum
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestLimiter(t *testing.T) {
+	type kind int
+
+	read := New[kind]("Read")
+	write := New[kind]("Write")
+	old := New[kind]("Old")
+	Retire(old, read)
+
+	l := NewLimiter[kind](rate.Every(time.Hour), 2).Set(write, rate.Every(time.Hour), 1)
+
+	for i := 0; i < 2; i++ {
+		if !l.Allow(read) {
+			t.Errorf("expected event %d for %s to be allowed", i, read)
+		}
+	}
+
+	if l.Allow(read) {
+		t.Errorf("expected event for %s to be limited", read)
+	}
+
+	if !l.Allow(write) || l.Allow(write) {
+		t.Errorf("expected a single event for %s to be allowed", write)
+	}
+
+	// Retired enums share the default bucket.
+	if !l.Allow(old) || !l.Allow(old) || l.Allow(old) {
+		t.Errorf("expected two events for %s to be allowed", old)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.Wait(ctx, read); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if l.Limiter(write).Burst() != 1 {
+		t.Errorf("expected burst 1, got %d", l.Limiter(write).Burst())
+	}
+
+	expectPanic(t, func() {
+		l.Set(old, rate.Inf, 1)
+	})
+
+	expectPanic(t, func() {
+		l.Allow(Enum[kind]{})
+	})
+}
@@ -0,0 +1,48 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ParseList parses a list of names associated with type T separated by sep
+// (for example, "read, write" with sep ","). White space around names is
+// ignored, as are empty segments. If any name is invalid, a non-nil error
+// identifying it is returned.
+func ParseList[T constraints.Integer](s, sep string) ([]Enum[T], error) {
+	if sep == "" {
+		return nil, fmt.Errorf("separator cannot be empty")
+	}
+
+	segments := strings.Split(s, sep)
+
+	values := make([]Enum[T], 0, len(segments))
+	for i, segment := range segments {
+		name := strings.TrimSpace(segment)
+		if name == "" {
+			continue
+		}
+
+		e, err := EnumByTypeAndName[T](name)
+		if err != nil {
+			return nil, fmt.Errorf("segment %d: %w", i, err)
+		}
+
+		values = append(values, e)
+	}
+
+	return values, nil
+}
+
+// JoinNames returns the names of the given enums separated by sep. It is the
+// inverse of ParseList. This panics if any of the enums is not valid.
+func JoinNames[T constraints.Integer](values []Enum[T], sep string) string {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = v.Name()
+	}
+
+	return strings.Join(names, sep)
+}
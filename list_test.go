@@ -0,0 +1,35 @@
+package enum
+
+import "testing"
+
+func TestParseList(t *testing.T) {
+	values, err := ParseList[Permission](" Read,, Write ,", ",")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(values) != 2 || PermissionEnum(values[0]) != Read || PermissionEnum(values[1]) != Write {
+		t.Errorf("expected [Read Write], got %v", values)
+	}
+
+	if s := JoinNames(values, ","); s != "Read,Write" {
+		t.Errorf("expected Read,Write, got %s", s)
+	}
+
+	values, err = ParseList[Permission]("  ", ",")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(values) != 0 {
+		t.Errorf("expected no values, got %v", values)
+	}
+}
+
+func TestParseList_Invalid(t *testing.T) {
+	if _, err := ParseList[Permission]("Read,Execute", ","); err == nil {
+		t.Errorf("expected error for unknown name")
+	}
+	if _, err := ParseList[Permission]("Read", ""); err == nil {
+		t.Errorf("expected error for empty separator")
+	}
+}
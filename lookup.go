@@ -0,0 +1,161 @@
+package enum
+
+import "golang.org/x/exp/constraints"
+
+// maxPerfectLookup is the maximum number of names of a set for which a
+// perfectLookup is built. Larger sets use nameEnumMap only.
+const maxPerfectLookup = 4096
+
+// perfectLookup is a collision-free hash table of the names of the enums of a
+// frozen set. As no more enums can be registered in a frozen set, it is built
+// once (see Freeze) and then read without holding the lock of the set, which
+// makes lookups of registered names faster than using nameEnumMap. Names not
+// found in it must still be looked up in nameEnumMap, as unrecognized enums
+// can be added after freezing.
+type perfectLookup[T constraints.Integer] struct {
+	// normalizer is the normalizer of the set when the table was built.
+	normalizer func(string) string
+
+	// seed and shift map the hash of a name to its slot. If sampled is true,
+	// only the length and the first, middle and last bytes of names are
+	// hashed.
+	seed    uint32
+	shift   uint32
+	sampled bool
+
+	keys  []string
+	enums []*internalEnum[T]
+}
+
+// newPerfectLookup returns a perfectLookup for the given names (normalized
+// with the given normalizer) or nil if none could be found.
+func newPerfectLookup[T constraints.Integer](names map[string]*internalEnum[T], normalizer func(string) string) *perfectLookup[T] {
+	if len(names) == 0 || len(names) > maxPerfectLookup {
+		return nil
+	}
+
+	bits := uint32(1)
+	for 1<<bits < 2*len(names) {
+		bits++
+	}
+
+	// Hashing only a few bytes is faster but fails for names that share them
+	// (like "Reader" and "Render"), so hashing all bytes is the fallback.
+	for _, sampled := range []bool{true, false} {
+		for size := bits; size < bits+3; size++ {
+			l := &perfectLookup[T]{
+				normalizer: normalizer,
+				shift:      32 - size,
+				sampled:    sampled,
+				keys:       make([]string, 1<<size),
+				enums:      make([]*internalEnum[T], 1<<size),
+			}
+
+			var state uint32
+			for attempt := 0; attempt < 64; attempt++ {
+				state, l.seed = nextSeed(state)
+				if l.fill(names) {
+					return l
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// fill stores the given names in the table, returning false if two of them
+// have the same slot.
+func (l *perfectLookup[T]) fill(names map[string]*internalEnum[T]) bool {
+	for i := range l.keys {
+		l.keys[i], l.enums[i] = "", nil
+	}
+
+	for name, e := range names {
+		i := perfectSlot(name, l.seed, l.shift, l.sampled)
+		if l.enums[i] != nil {
+			return false
+		}
+
+		l.keys[i], l.enums[i] = name, e
+	}
+
+	return true
+}
+
+// get returns the enum with the given name or nil.
+func (l *perfectLookup[T]) get(name string) *internalEnum[T] {
+	if l.normalizer != nil {
+		name = l.normalizer(name)
+	}
+
+	i := perfectSlot(name, l.seed, l.shift, l.sampled)
+	if l.keys[i] != name {
+		return nil
+	}
+
+	return l.enums[i]
+}
+
+// getBytes is like get but takes the name as a byte slice. It does not
+// allocate unless there is a normalizer.
+func (l *perfectLookup[T]) getBytes(name []byte) *internalEnum[T] {
+	if l.normalizer != nil {
+		return l.get(string(name))
+	}
+
+	i := perfectSlot(name, l.seed, l.shift, l.sampled)
+	if l.keys[i] != string(name) {
+		return nil
+	}
+
+	return l.enums[i]
+}
+
+// perfectSlot returns the slot of the given name in a perfectLookup.
+func perfectSlot[S string | []byte](name S, seed, shift uint32, sampled bool) uint32 {
+	var h uint32
+
+	if n := len(name); sampled {
+		if n > 0 {
+			h = uint32(name[0]) | uint32(name[n/2])<<8 | uint32(name[n-1])<<16
+		}
+
+		h ^= uint32(n) << 24
+	} else {
+		// FNV-1a.
+		h = 2166136261
+		for i := 0; i < n; i++ {
+			h = (h ^ uint32(name[i])) * 16777619
+		}
+	}
+
+	return (h * seed) >> shift
+}
+
+// nextSeed returns the next state and seed of the sequence of seeds tried by
+// newPerfectLookup. Seeds are odd, so multiplying by them is a bijection.
+func nextSeed(state uint32) (uint32, uint32) {
+	state += 0x9e3779b9
+
+	z := state
+	z = (z ^ z>>16) * 0x85ebca6b
+	z = (z ^ z>>13) * 0xc2b2ae35
+	z ^= z >> 16
+
+	return state, z | 1
+}
+
+// perfect returns the perfectLookup of the set or nil if the set is not
+// frozen (or none could be built).
+func (s *internalSet[T]) perfect() *perfectLookup[T] {
+	l, _ := s.lookup.Load().(*perfectLookup[T])
+
+	return l
+}
+
+// buildPerfectLookup builds the perfectLookup of the set. It must be called
+// with the lock held.
+func (s *internalSet[T]) buildPerfectLookup() {
+	s.lookup.Store(newPerfectLookup(s.nameEnumMap, s.normalizer))
+}
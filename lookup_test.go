@@ -0,0 +1,109 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestPerfectLookup(t *testing.T) {
+	for _, names := range [][]string{
+		{"Admin", "User", "Guest"},
+		// Names sharing the length and sampled bytes.
+		{"Reader", "Render", "Ranker", "Rocker"},
+		{"A", "B", "AB", "BA", "ABA", "BAB"},
+	} {
+		set := map[string]*internalEnum[int]{}
+		for i, name := range names {
+			set[name] = &internalEnum[int]{name: name, id: i}
+		}
+
+		l := newPerfectLookup(set, nil)
+		if l == nil {
+			t.Fatalf("expected a lookup for %v", names)
+		}
+
+		for name, e := range set {
+			if got := l.get(name); got != e {
+				t.Errorf("expected %v for %s, got %v", e, name, got)
+			}
+
+			if got := l.getBytes([]byte(name)); got != e {
+				t.Errorf("expected %v for %s, got %v", e, name, got)
+			}
+		}
+
+		for _, name := range []string{"", "Other", "admin", "Readers"} {
+			if got := l.get(name); got != nil {
+				t.Errorf("expected nil for %q, got %v", name, got)
+			}
+		}
+	}
+
+	if l := newPerfectLookup(map[string]*internalEnum[int]{}, nil); l != nil {
+		t.Errorf("expected no lookup for an empty set")
+	}
+}
+
+func TestFreeze_Lookup(t *testing.T) {
+	type size int
+
+	small := New[size]("Small")
+	large := New[size]("Large")
+
+	Open[size]()
+	Freeze[size]()
+
+	for _, name := range []string{"Small", "Large"} {
+		e, err := EnumByTypeAndName[size](name)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if e.Name() != name {
+			t.Errorf("expected %s, got %s", name, e)
+		}
+	}
+
+	// Unrecognized enums are added after freezing.
+	huge, err := EnumByTypeAndName[size]("Huge")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if again, err := EnumByTypeAndName[size]("Huge"); err != nil || again != huge {
+		t.Errorf("expected %v, got %v (%v)", huge, again, err)
+	}
+
+	SetNormalizer[size](strings.ToLower)
+
+	for expected, name := range map[Enum[size]]string{small: "SMALL", large: "large", huge: "HUGE"} {
+		var e Enum[size]
+		if err := e.UnmarshalText([]byte(name)); err != nil || e != expected {
+			t.Errorf("expected %v for %s, got %v (%v)", expected, name, e, err)
+		}
+	}
+}
+
+func BenchmarkParse_Frozen(b *testing.B) {
+	type frozenRole int
+
+	names := []string{"Unknown", "Admin", "User", "Guest"}
+	for _, name := range names {
+		New[frozenRole](name)
+	}
+
+	for _, frozen := range []bool{false, true} {
+		if frozen {
+			Freeze[frozenRole]()
+		}
+
+		b.Run(fmt.Sprintf("frozen=%t", frozen), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				_, _ = EnumByTypeAndName[frozenRole](names[i%len(names)])
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Mapping is a two-way correspondence between enums associated with type A
+// and enums associated with type B (for example, an internal Role and the
+// Role exposed by an external API). Each enum can be mapped at most once on
+// each side.
+//
+// Mappings are usually built during initialization and are safe for
+// concurrent use after that.
+type Mapping[A, B constraints.Integer] struct {
+	// Keyed by ID so copies of the enums are also handled.
+	to   map[A]Enum[B]
+	from map[B]Enum[A]
+}
+
+// NewMapping returns a new empty Mapping.
+func NewMapping[A, B constraints.Integer]() *Mapping[A, B] {
+	return &Mapping[A, B]{
+		to:   make(map[A]Enum[B]),
+		from: make(map[B]Enum[A]),
+	}
+}
+
+// Add adds a correspondence between a and b and returns the Mapping itself so
+// calls can be chained. This panics if any of the enums is invalid or was
+// already mapped.
+func (m *Mapping[A, B]) Add(a Member[A], b Member[B]) *Mapping[A, B] {
+	ea := Enum[A]{a.wrapper()}
+	eb := Enum[B]{b.wrapper()}
+
+	if !ea.Valid() {
+		panic(notInitialized[A]())
+	}
+
+	if !eb.Valid() {
+		panic(notInitialized[B]())
+	}
+
+	if _, ok := m.to[ea.ID()]; ok {
+		panic(fmt.Sprintf("enum %s already mapped", ea))
+	}
+
+	if _, ok := m.from[eb.ID()]; ok {
+		panic(fmt.Sprintf("enum %s already mapped", eb))
+	}
+
+	m.to[ea.ID()] = eb
+	m.from[eb.ID()] = ea
+
+	return m
+}
+
+// MapTo returns the enum associated with type B that corresponds to the given
+// one. If there is no such enum, a non-nil error is returned.
+func (m *Mapping[A, B]) MapTo(a Member[A]) (Enum[B], error) {
+	ea := Enum[A]{a.wrapper()}
+	if !ea.Valid() {
+		return Enum[B]{}, fmt.Errorf("enum not initialized")
+	}
+
+	eb, ok := m.to[ea.ID()]
+	if !ok {
+		return Enum[B]{}, fmt.Errorf("enum %s of type %s is not mapped to type %s", ea, getTypeName[A](), getTypeName[B]())
+	}
+
+	return eb, nil
+}
+
+// MapFrom returns the enum associated with type A that corresponds to the
+// given one. If there is no such enum, a non-nil error is returned.
+func (m *Mapping[A, B]) MapFrom(b Member[B]) (Enum[A], error) {
+	eb := Enum[B]{b.wrapper()}
+	if !eb.Valid() {
+		return Enum[A]{}, fmt.Errorf("enum not initialized")
+	}
+
+	ea, ok := m.from[eb.ID()]
+	if !ok {
+		return Enum[A]{}, fmt.Errorf("enum %s of type %s is not mapped to type %s", eb, getTypeName[B](), getTypeName[A]())
+	}
+
+	return ea, nil
+}
+
+// Complete returns a non-nil error listing all enums associated with types A
+// and B that are not mapped.
+func (m *Mapping[A, B]) Complete() error {
+	var missing []string
+
+	for _, e := range EnumsByType[A]() {
+		if _, ok := m.to[e.ID()]; !ok {
+			missing = append(missing, getTypeName[A]()+"."+e.Name())
+		}
+	}
+
+	for _, e := range EnumsByType[B]() {
+		if _, ok := m.from[e.ID()]; !ok {
+			missing = append(missing, getTypeName[B]()+"."+e.Name())
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("unmapped enums: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
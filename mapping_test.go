@@ -0,0 +1,71 @@
+package enum
+
+import "testing"
+
+type apiRole int
+
+var (
+	apiRoleNone   = New[apiRole]("NONE")
+	apiRoleAdmin  = New[apiRole]("ADMIN")
+	apiRoleMember = New[apiRole]("MEMBER")
+	apiRoleGuest  = New[apiRole]("GUEST")
+)
+
+func newRoleMapping() *Mapping[Role, apiRole] {
+	return NewMapping[Role, apiRole]().
+		Add(UnknownRole, apiRoleNone).
+		Add(Admin, apiRoleAdmin).
+		Add(User, apiRoleMember)
+}
+
+func TestMapping(t *testing.T) {
+	m := newRoleMapping()
+
+	b, err := m.MapTo(User)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if b != apiRoleMember {
+		t.Errorf("expected %s, got %s", apiRoleMember, b)
+	}
+
+	a, err := m.MapFrom(apiRoleAdmin)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if RoleEnum(a) != Admin {
+		t.Errorf("expected %s, got %s", Admin, a)
+	}
+
+	if _, err := m.MapTo(Guest); err == nil {
+		t.Errorf("expected error for unmapped enum")
+	}
+	if _, err := m.MapFrom(apiRoleGuest); err == nil {
+		t.Errorf("expected error for unmapped enum")
+	}
+	if _, err := m.MapTo(RoleEnum{}); err == nil {
+		t.Errorf("expected error for invalid enum")
+	}
+}
+
+func TestMapping_Complete(t *testing.T) {
+	m := newRoleMapping()
+	if err := m.Complete(); err == nil {
+		t.Fatalf("expected error for incomplete mapping")
+	}
+
+	m.Add(Guest, apiRoleGuest)
+	if err := m.Complete(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestMapping_Duplicate(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic, got normal execution")
+		}
+	}()
+
+	newRoleMapping().Add(Admin, apiRoleGuest)
+}
@@ -0,0 +1,250 @@
+package enum
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// HookOption configures the behavior of the hook returned by MapstructureHook.
+type HookOption func(*hookConfig)
+
+type hookConfig struct {
+	lenient bool
+
+	// Both maps are keyed by the associated type T and store
+	// *internalEnum[T] values.
+	defaults map[reflect.Type]any
+	aliases  map[reflect.Type]map[string]any
+}
+
+// HookLenient makes the hook trim surrounding whitespace from names, match
+// names case-insensitively and accept IDs passed as strings. Exact names are
+// preferred and, if names of several enums only differ by case, the first
+// registered one is used. By default, the hook is strict and only accepts
+// exact names (and numeric IDs).
+func HookLenient() HookOption {
+	return func(c *hookConfig) {
+		c.lenient = true
+	}
+}
+
+// HookDefault makes the hook decode empty strings to the given value instead
+// of failing. It applies to all destination types associated with the same
+// type T.
+func HookDefault[E EnumType[T], T constraints.Integer](value E) HookOption {
+	e := struct{ internalEnumWrapper[T] }(value).internalEnum
+	if e == nil {
+		panic(notInitialized[T]())
+	}
+
+	return func(c *hookConfig) {
+		if c.defaults == nil {
+			c.defaults = make(map[reflect.Type]any)
+		}
+
+		c.defaults[getType[T]()] = e
+	}
+}
+
+// HookAliases makes the hook accept the given alternative names for values of
+// the associated type T. Aliases are matched the same way as names (so they
+// are case-insensitive when HookLenient is also used).
+func HookAliases[E EnumType[T], T constraints.Integer](aliases map[string]E) HookOption {
+	m := make(map[string]any, len(aliases))
+	for alias, value := range aliases {
+		e := struct{ internalEnumWrapper[T] }(value).internalEnum
+		if e == nil {
+			panic(notInitialized[T]())
+		}
+
+		m[alias] = e
+	}
+
+	return func(c *hookConfig) {
+		if c.aliases == nil {
+			c.aliases = make(map[reflect.Type]map[string]any)
+		}
+
+		t := getType[T]()
+		if c.aliases[t] == nil {
+			c.aliases[t] = make(map[string]any, len(m))
+		}
+
+		for alias, e := range m {
+			c.aliases[t][alias] = e
+		}
+	}
+}
+
+// enumDecoder is implemented by pointers to Enum[T] and to every type derived
+// from it. It is used to decode values when the actual type T is only known
+// through reflection.
+type enumDecoder interface {
+	decodeName(name string, lenient bool) error
+	decodeID(id int64) error
+
+	// assign sets the internal enum, which must be an *internalEnum[T].
+	assign(e any)
+
+	// enumType returns the associated type T.
+	enumType() reflect.Type
+
+	// names returns the names of all enums associated with type T.
+	names() []string
+}
+
+var enumDecoderType = reflect.TypeOf((*enumDecoder)(nil)).Elem()
+
+// MapstructureHook returns a function that can be used as a
+// mapstructure.DecodeHookFunc (for example, through viper.DecodeHook). It
+// converts strings (names) and integers (IDs) to registered enum values for
+// any destination type that is an Enum[T] or derived from one. Values for
+// other destination types are passed through untouched.
+//
+// The returned function is not tied to a specific mapstructure version so it
+// can be used with any of its forks.
+func MapstructureHook(opts ...HookOption) func(from reflect.Type, to reflect.Type, data any) (any, error) {
+	var c hookConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return func(from reflect.Type, to reflect.Type, data any) (any, error) {
+		if from == to || !isEnumType(to) {
+			return data, nil
+		}
+
+		target := reflect.New(to)
+		d := target.Interface().(enumDecoder)
+
+		var err error
+
+		v := reflect.ValueOf(data)
+		switch v.Kind() {
+		case reflect.String:
+			err = c.decodeName(d, v.String())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			err = d.decodeID(v.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if v.Uint() > math.MaxInt64 {
+				err = fmt.Errorf("id %d out of range for %s", v.Uint(), to)
+			} else {
+				err = d.decodeID(int64(v.Uint()))
+			}
+		case reflect.Float32, reflect.Float64:
+			// Numbers decoded from JSON or YAML sources are usually floats.
+			f := v.Float()
+			if f != math.Trunc(f) || f < math.MinInt64 || f > math.MaxInt64 {
+				err = fmt.Errorf("id %v is not a valid integer for %s", f, to)
+			} else {
+				err = d.decodeID(int64(f))
+			}
+		default:
+			err = fmt.Errorf("cannot decode %T into %s", data, to)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %v for %s (allowed values: %s): %w",
+				data, to, strings.Join(d.names(), ", "), err)
+		}
+
+		return target.Elem().Interface(), nil
+	}
+}
+
+func (c *hookConfig) decodeName(d enumDecoder, name string) error {
+	t := d.enumType()
+
+	if e, ok := c.defaults[t]; ok && strings.TrimSpace(name) == "" {
+		d.assign(e)
+		return nil
+	}
+
+	for alias, e := range c.aliases[t] {
+		if alias == name || (c.lenient && strings.EqualFold(alias, strings.TrimSpace(name))) {
+			d.assign(e)
+			return nil
+		}
+	}
+
+	return d.decodeName(name, c.lenient)
+}
+
+func (e *internalEnumWrapper[T]) decodeName(name string, lenient bool) error {
+	if !lenient {
+		return e.UnmarshalText([]byte(name))
+	}
+
+	name = strings.TrimSpace(name)
+
+	if id, err := strconv.ParseInt(name, 10, 64); err == nil {
+		return e.decodeID(id)
+	}
+
+	ie, err := getInternalEnumForNameFold[T](name)
+	if err != nil {
+		return err
+	}
+
+	e.internalEnum = ie
+
+	return nil
+}
+
+func (e *internalEnumWrapper[T]) decodeID(id int64) error {
+	if int64(T(id)) != id {
+		return fmt.Errorf("id %d out of range for type %s", id, getTypeName[T]())
+	}
+
+	s, err := getSetForType[T]()
+	if err != nil {
+		return err
+	}
+
+	ie, err := getInternalEnumForID(T(id))
+	if err != nil {
+		return err
+	}
+
+	e.internalEnum = s.Resolve(ie)
+
+	return nil
+}
+
+func (e *internalEnumWrapper[T]) assign(ie any) {
+	e.internalEnum = ie.(*internalEnum[T])
+}
+
+func (e *internalEnumWrapper[T]) enumType() reflect.Type {
+	return getType[T]()
+}
+
+func (e *internalEnumWrapper[T]) names() []string {
+	return Names[T]()
+}
+
+func getInternalEnumForNameFold[T constraints.Integer](name string) (*internalEnum[T], error) {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	if e := s.GetFold(name); e != nil {
+		return resolveDecoded(s, e)
+	}
+
+	if e := s.Unknown(); e != nil && name == "" {
+		return e, nil
+	}
+
+	if s.Open() {
+		return s.AddUnrecognized(name)
+	}
+
+	return nil, fmt.Errorf("name %s could not be found in enum set for type %s", name, getTypeName[T]())
+}
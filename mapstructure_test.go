@@ -0,0 +1,97 @@
+package enum
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMapstructureHook(t *testing.T) {
+	roleType := reflect.TypeOf(RoleEnum{})
+
+	tests := []struct {
+		name    string
+		opts    []HookOption
+		data    any
+		want    RoleEnum
+		wantErr bool
+	}{
+		{"name", nil, "Admin", Admin, false},
+		{"int id", nil, 2, User, false},
+		{"uint id", nil, uint8(3), Guest, false},
+		{"float id", nil, float64(1), Admin, false},
+		{"non-integral float", nil, 1.5, RoleEnum{}, true},
+		{"unknown name", nil, "Root", RoleEnum{}, true},
+		{"unknown id", nil, 42, RoleEnum{}, true},
+		{"strict case", nil, "admin", RoleEnum{}, true},
+		{"strict spaces", nil, " Admin ", RoleEnum{}, true},
+		{"strict numeric string", nil, "1", RoleEnum{}, true},
+		{"lenient case", []HookOption{HookLenient()}, " admin ", Admin, false},
+		{"lenient numeric string", []HookOption{HookLenient()}, "3", Guest, false},
+		{"unsupported type", nil, []string{"Admin"}, RoleEnum{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hook := MapstructureHook(test.opts...)
+
+			got, err := hook(reflect.TypeOf(test.data), roleType, test.data)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.(RoleEnum) != test.want {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestMapstructureHook_LenientCaseCollision(t *testing.T) {
+	type mode int
+
+	type modeEnum Enum[mode]
+
+	read := modeEnum(New[mode]("Read"))
+	New[mode]("READ")
+	New[mode]("ReAd")
+
+	hook := MapstructureHook(HookLenient())
+
+	// The first registered enum wins regardless of map iteration order.
+	for i := 0; i < 20; i++ {
+		got, err := hook(reflect.TypeOf(""), reflect.TypeOf(read), "read")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got.(modeEnum) != read {
+			t.Fatalf("expected %s, got %s", Enum[mode](read), Enum[mode](got.(modeEnum)))
+		}
+	}
+}
+
+func TestMapstructureHook_PassThrough(t *testing.T) {
+	hook := MapstructureHook()
+
+	got, err := hook(reflect.TypeOf(""), reflect.TypeOf(""), "Admin")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != "Admin" {
+		t.Errorf("expected data to be passed through, got %v", got)
+	}
+
+	got, err = hook(reflect.TypeOf(Admin), reflect.TypeOf(Admin), Admin)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != Admin {
+		t.Errorf("expected data to be passed through, got %v", got)
+	}
+}
@@ -0,0 +1,180 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// metadataLoader loads metadata on first access.
+type metadataLoader struct {
+	once   sync.Once
+	load   func() map[string]string
+	values map[string]string
+}
+
+// Metadata associates the given key and value with the Enum.
+func Metadata(key, value string) Option {
+	return func(a *attributes) {
+		if a.metadata == nil {
+			a.metadata = make(map[string]string)
+		}
+
+		a.metadata[key] = value
+	}
+}
+
+// MetadataLoader makes the metadata of the Enum be loaded by the given
+// function the first time it is accessed, so metadata that is expensive to
+// load (for example, descriptions from a translations service) does not
+// slow down startup. Loaded values take precedence over the ones set with the
+// Metadata option. The function is called at most once and may be called
+// concurrently with the loaders of other enums.
+func MetadataLoader(load func() map[string]string) Option {
+	return func(a *attributes) {
+		a.loader = &metadataLoader{load: load}
+	}
+}
+
+// Metadata returns a copy of all metadata associated with this Enum.
+func (e internalEnumWrapper[T]) Metadata() map[string]string {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	loaded := e.internalEnum.loadedMetadata()
+
+	metadata := make(map[string]string, len(e.internalEnum.metadata)+len(loaded))
+	for key, value := range e.internalEnum.metadata {
+		metadata[key] = value
+	}
+
+	for key, value := range loaded {
+		metadata[key] = value
+	}
+
+	for key, value := range e.internalEnum.updatedMetadata() {
+		metadata[key] = value
+	}
+
+	return metadata
+}
+
+// MetadataValue returns the metadata value associated with the given key and
+// true, or false if there is none.
+func (e internalEnumWrapper[T]) MetadataValue(key string) (string, bool) {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if value, ok := e.internalEnum.updatedMetadata()[key]; ok {
+		return value, true
+	}
+
+	if value, ok := e.internalEnum.loadedMetadata()[key]; ok {
+		return value, true
+	}
+
+	value, ok := e.internalEnum.metadata[key]
+
+	return value, ok
+}
+
+// loadedMetadata returns the metadata returned by the loader, calling it if
+// needed.
+func (a *attributes) loadedMetadata() map[string]string {
+	l := a.loader
+	if l == nil {
+		return nil
+	}
+
+	l.once.Do(func() {
+		l.values = l.load()
+	})
+
+	return l.values
+}
+
+// UpdateMetadata sets the metadata of the given value at runtime, for example
+// to fix a typo in a label or a translation without a deploy. Only Metadata
+// options can be given: the identity of the value (its name and ID) and its
+// other attributes can not change. Updated values take precedence over the
+// ones set when the value was registered (including loaded ones, see
+// MetadataLoader) and other keys are kept. Readers see either all of the
+// given values or none of them. This returns a non-nil error if the value is
+// not initialized or not registered (for example, if it is a copy; see
+// Canonical), if other options are given or if a key is part of the identity
+// of the value (see IdentityMetadata).
+func UpdateMetadata[E EnumType[T], T constraints.Integer](value E, opts ...Option) error {
+	e := Enum[T](value)
+	if !e.Valid() {
+		return fmt.Errorf("enum not initialized")
+	}
+
+	var attrs attributes
+	for _, opt := range opts {
+		opt(&attrs)
+	}
+
+	metadata := attrs.metadata
+	if attrs.metadata = nil; !reflect.DeepEqual(attrs, attributes{}) {
+		return fmt.Errorf("only Metadata options can be used to update the metadata of enum %s", e.name)
+	}
+
+	if e.set == nil {
+		return fmt.Errorf("enum %s of type %s is not registered", e.name, getTypeName[T]())
+	}
+
+	if err := e.set.UpdateMetadata(e.internalEnum, metadata); err != nil {
+		return err
+	}
+
+	emit(EventMetadataUpdated, e.internalEnum)
+
+	return nil
+}
+
+// updatedMetadata returns the metadata set with UpdateMetadata.
+func (e *internalEnum[T]) updatedMetadata() map[string]string {
+	updated, _ := e.updates.Load().(map[string]string)
+
+	return updated
+}
+
+// UpdateMetadata merges the given metadata into the metadata of the given
+// enum set with UpdateMetadata. This returns a non-nil error if the enum is
+// not registered in the set or if a key is an identity key.
+func (s *internalSet[T]) UpdateMetadata(e *internalEnum[T], metadata map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.nameEnumMap[s.key(e.name)] != e || e.unrecognized {
+		return fmt.Errorf("enum %s of type %s is not registered", e.name, getTypeName[T]())
+	}
+
+	for key := range metadata {
+		if contains(s.identityKeys, key) {
+			return fmt.Errorf("metadata %s of enum %s of type %s is part of its identity and can not be updated",
+				key, e.name, getTypeName[T]())
+		}
+	}
+
+	current := e.updatedMetadata()
+
+	updated := make(map[string]string, len(current)+len(metadata))
+	for key, value := range current {
+		updated[key] = value
+	}
+
+	for key, value := range metadata {
+		updated[key] = value
+	}
+
+	// The map is never modified once stored, so it can be read without
+	// locking.
+	e.updates.Store(updated)
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package enum
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMetadata(t *testing.T) {
+	type country int
+
+	loads := 0
+
+	pt := New[country]("PT",
+		Metadata("name", "Portugal"),
+		Metadata("code", "PRT"),
+		MetadataLoader(func() map[string]string {
+			loads++
+
+			return map[string]string{"name": "Portuguese Republic", "capital": "Lisbon"}
+		}))
+	es := New[country]("ES", Metadata("name", "Spain"))
+
+	if loads != 0 {
+		t.Fatalf("expected metadata not to be loaded before first access")
+	}
+
+	if name, _ := pt.MetadataValue("name"); name != "Portuguese Republic" {
+		t.Errorf("expected loaded value, got %q", name)
+	}
+
+	if code, ok := pt.MetadataValue("code"); !ok || code != "PRT" {
+		t.Errorf("expected PRT, got %q", code)
+	}
+
+	if _, ok := pt.MetadataValue("missing"); ok {
+		t.Errorf("expected no value")
+	}
+
+	metadata := pt.Metadata()
+	if len(metadata) != 3 || metadata["capital"] != "Lisbon" {
+		t.Errorf("unexpected metadata: %v", metadata)
+	}
+
+	if loads != 1 {
+		t.Errorf("expected metadata to be loaded once, got %d", loads)
+	}
+
+	metadata = es.Metadata()
+	metadata["name"] = "changed"
+
+	if name, _ := es.MetadataValue("name"); name != "Spain" {
+		t.Errorf("expected metadata to be unaffected by changes to returned map, got %q", name)
+	}
+}
+
+func TestUpdateMetadata(t *testing.T) {
+	type plan int
+
+	pro := New[plan]("Pro",
+		Metadata("label", "Profesional"),
+		Metadata("tier", "2"),
+		MetadataLoader(func() map[string]string {
+			return map[string]string{"label_pt": "Profisional"}
+		}),
+	)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for i := 0; i < 100; i++ {
+			_, _ = pro.MetadataValue("label")
+			_ = pro.Metadata()
+		}
+	}()
+
+	if err := UpdateMetadata(pro, Metadata("label", "Professional"), Metadata("label_pt", "Profissional")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	<-done
+
+	expected := map[string]string{"label": "Professional", "label_pt": "Profissional", "tier": "2"}
+	if got := pro.Metadata(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if err := UpdateMetadata(pro, Metadata("tier", "3")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if label, _ := pro.MetadataValue("label"); label != "Professional" {
+		t.Errorf("expected previous updates to be kept, got %s", label)
+	}
+
+	if tier, _ := pro.MetadataValue("tier"); tier != "3" || pro.Name() != "Pro" || pro.ID() != 0 {
+		t.Errorf("unexpected value %s (%d) with tier %s", pro, pro.ID(), tier)
+	}
+
+	if err := UpdateMetadata(pro, Deprecated()); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if err := UpdateMetadata(Enum[plan]{}, Metadata("label", "None")); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	cp := &internalEnum[plan]{name: "Pro", set: pro.set}
+	if err := UpdateMetadata(Enum[plan]{internalEnumWrapper[plan]{cp}}, Metadata("label", "Copy")); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
@@ -0,0 +1,175 @@
+// Package mimetype provides enums for common MIME types (media types), each
+// with its file extensions and whether its content is binary. It is built
+// entirely on the public enum API and also serves as a reference for large,
+// data-driven enums with attached data.
+//
+// Names are MIME types without parameters. They are matched case
+// insensitively and parameters are ignored, so "Text/HTML; charset=utf-8"
+// parses as HTML, including when decoding JSON, text or SQL values:
+//
+//	t, err := mimetype.Parse(r.Header.Get("Content-Type"))
+//
+// Other types can be added with Register.
+package mimetype
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/bruno-ga/enum"
+)
+
+// ID is the type associated with MIME type enums.
+type ID uint16
+
+// Type is a MIME type.
+type Type enum.Enum[ID]
+
+// Metadata key and tag used to store the attached data of types.
+const (
+	// ExtensionsKey is the metadata key holding the file extensions of a type,
+	// separated by commas.
+	ExtensionsKey = "extensions"
+
+	// BinaryTag is the tag of types with binary content.
+	BinaryTag = "binary"
+)
+
+// Common MIME types.
+var (
+	Binary = Register("application/octet-stream", true, ".bin")
+	JSON   = Register("application/json", false, ".json")
+	XML    = Register("application/xml", false, ".xml")
+	PDF    = Register("application/pdf", true, ".pdf")
+	Zip    = Register("application/zip", true, ".zip")
+	Gzip   = Register("application/gzip", true, ".gz")
+	Tar    = Register("application/x-tar", true, ".tar")
+	Wasm   = Register("application/wasm", true, ".wasm")
+	YAML   = Register("application/yaml", false, ".yaml", ".yml")
+	Form   = Register("application/x-www-form-urlencoded", false)
+
+	Protobuf   = Register("application/x-protobuf", true, ".pb")
+	JavaScript = Register("text/javascript", false, ".js", ".mjs")
+
+	Plain    = Register("text/plain", false, ".txt")
+	HTML     = Register("text/html", false, ".html", ".htm")
+	CSS      = Register("text/css", false, ".css")
+	CSV      = Register("text/csv", false, ".csv")
+	Markdown = Register("text/markdown", false, ".md")
+
+	PNG  = Register("image/png", true, ".png")
+	JPEG = Register("image/jpeg", true, ".jpg", ".jpeg")
+	GIF  = Register("image/gif", true, ".gif")
+	WebP = Register("image/webp", true, ".webp")
+	SVG  = Register("image/svg+xml", false, ".svg")
+	ICO  = Register("image/vnd.microsoft.icon", true, ".ico")
+
+	MP3  = Register("audio/mpeg", true, ".mp3")
+	OGG  = Register("audio/ogg", true, ".ogg")
+	WAV  = Register("audio/wav", true, ".wav")
+	MP4  = Register("video/mp4", true, ".mp4")
+	WebM = Register("video/webm", true, ".webm")
+
+	WOFF2     = Register("font/woff2", true, ".woff2")
+	Multipart = Register("multipart/form-data", true)
+)
+
+var (
+	setup sync.Once
+
+	mu          sync.RWMutex
+	byExtension = make(map[string]Type)
+)
+
+// Register registers a MIME type with the given name (without parameters)
+// and attached data and returns it. Extensions are given with a leading dot.
+// Like enum.New, this panics if the name is already registered. It also
+// panics if an extension is already used by another type.
+func Register(name string, binary bool, extensions ...string) Type {
+	setup.Do(func() {
+		enum.SetNormalizer[ID](normalize)
+	})
+
+	lower := make([]string, 0, len(extensions))
+	for _, ext := range extensions {
+		lower = append(lower, strings.ToLower(ext))
+	}
+
+	extensions = lower
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, ext := range extensions {
+		if other, ok := byExtension[ext]; ok {
+			panic(fmt.Sprintf("extension %s already used by %s", ext, other.Name()))
+		}
+	}
+
+	opts := []enum.Option{enum.Metadata(ExtensionsKey, strings.Join(extensions, ","))}
+	if binary {
+		opts = append(opts, enum.Tag(BinaryTag))
+	}
+
+	t := Type(enum.New[ID](name, opts...))
+
+	for _, ext := range extensions {
+		byExtension[ext] = t
+	}
+
+	return t
+}
+
+// normalize removes parameters from MIME types and converts them to lower
+// case.
+func normalize(name string) string {
+	name, _, _ = strings.Cut(name, ";")
+
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Parse returns the type with the given name, ignoring case and parameters.
+func Parse(name string) (Type, error) {
+	e, err := enum.EnumByTypeAndName[ID](name)
+
+	return Type(e), err
+}
+
+// ByExtension returns the type associated with the given file extension
+// (with a leading dot, ignoring case) and true, or false if there is none.
+func ByExtension(ext string) (Type, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	t, ok := byExtension[strings.ToLower(ext)]
+
+	return t, ok
+}
+
+// Values returns all registered types sorted by ID.
+func Values() []Type {
+	enums := enum.EnumsByType[ID]()
+
+	types := make([]Type, 0, len(enums))
+	for _, e := range enums {
+		types = append(types, Type(e))
+	}
+
+	return types
+}
+
+// Extensions returns the file extensions of this type.
+func (t Type) Extensions() []string {
+	value, _ := t.MetadataValue(ExtensionsKey)
+	if value == "" {
+		return nil
+	}
+
+	return strings.Split(value, ",")
+}
+
+// IsBinary returns true if the content of this type is binary.
+func (t Type) IsBinary() bool {
+	return t.HasTag(BinaryTag)
+}
@@ -0,0 +1,75 @@
+package mimetype
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, name := range []string{"text/html", "Text/HTML; charset=utf-8", " text/html "} {
+		if typ, err := Parse(name); err != nil || typ != HTML {
+			t.Errorf("expected %s for %q, got %v (%v)", HTML.Name(), name, typ, err)
+		}
+	}
+
+	if _, err := Parse("application/unknown"); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	var v struct {
+		Type Type `json:"type"`
+	}
+	if err := json.Unmarshal([]byte(`{"type":"application/JSON; charset=utf-8"}`), &v); err != nil || v.Type != JSON {
+		t.Errorf("expected %s, got %v (%v)", JSON.Name(), v.Type, err)
+	}
+}
+
+func TestAttachedData(t *testing.T) {
+	if exts := JPEG.Extensions(); !reflect.DeepEqual(exts, []string{".jpg", ".jpeg"}) {
+		t.Errorf("unexpected extensions %v", exts)
+	}
+
+	if exts := Form.Extensions(); exts != nil {
+		t.Errorf("unexpected extensions %v", exts)
+	}
+
+	if !PNG.IsBinary() || CSV.IsBinary() {
+		t.Errorf("unexpected binary flags")
+	}
+
+	if typ, ok := ByExtension(".JPEG"); !ok || typ != JPEG {
+		t.Errorf("expected %s, got %v (%v)", JPEG.Name(), typ, ok)
+	}
+
+	if _, ok := ByExtension(".unknown"); ok {
+		t.Errorf("expected no type")
+	}
+}
+
+var avif = Register("image/avif", true, ".AVIF")
+
+func TestRegister(t *testing.T) {
+	if typ, err := Parse("image/avif"); err != nil || typ != avif {
+		t.Errorf("expected %s, got %v (%v)", avif.Name(), typ, err)
+	}
+
+	if typ, ok := ByExtension(".avif"); !ok || typ != avif {
+		t.Errorf("expected %s, got %v (%v)", avif.Name(), typ, ok)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic for duplicate extension")
+		}
+	}()
+
+	Register("image/x-png", true, ".png")
+}
+
+func TestValues(t *testing.T) {
+	values := Values()
+	if len(values) == 0 || values[0] != Binary {
+		t.Errorf("unexpected values %v", values)
+	}
+}
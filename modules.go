@@ -0,0 +1,143 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// majorVersionElem matches the major version suffix of module paths (as in
+// example.com/roles/v2) and the major version of gopkg.in paths (as in
+// gopkg.in/roles.v2).
+var majorVersionElem = regexp.MustCompile(`(/v(?:[2-9]|[1-9][0-9]+)|\.v[0-9]+)(/|$)`)
+
+// logicalPackagePath returns the given package path without the major version
+// of its module, so the same package in different major versions of a module
+// has the same logical path.
+func logicalPackagePath(pkgPath string) string {
+	return majorVersionElem.ReplaceAllString(pkgPath, "$2")
+}
+
+var (
+	buildInfoOnce sync.Once
+	buildInfo     *debug.BuildInfo
+)
+
+// moduleOf returns the module (path and version) the package with the given
+// path belongs to according to info, or the package path if unknown.
+func moduleOf(pkgPath string, info *debug.BuildInfo) string {
+	if info == nil {
+		return pkgPath
+	}
+
+	var best *debug.Module
+	for _, m := range append([]*debug.Module{&info.Main}, info.Deps...) {
+		if m.Path == "" || (best != nil && len(m.Path) <= len(best.Path)) {
+			continue
+		}
+
+		if pkgPath == m.Path || strings.HasPrefix(pkgPath, m.Path+"/") {
+			best = m
+		}
+	}
+
+	switch {
+	case best == nil:
+		return pkgPath
+	case best.Version == "" || best.Version == "(devel)":
+		return best.Path
+	default:
+		return best.Path + "@" + best.Version
+	}
+}
+
+// versionProblems reports the types of the given sets that are the same
+// logical type (see logicalPackagePath) registered by different major versions
+// of a module. This happens when two dependencies require different major
+// versions of a shared package declaring enums (a diamond dependency): both
+// versions register their enums, so values appear twice (for example, in
+// Describe) and values decoded by one version are not compatible with the
+// other.
+func versionProblems(sets map[reflect.Type]any) []Problem {
+	typesByName := make(map[string][]reflect.Type)
+	for t := range sets {
+		if t.PkgPath() != "" && t.Name() != "" {
+			name := logicalPackagePath(t.PkgPath()) + "." + t.Name()
+			typesByName[name] = append(typesByName[name], t)
+		}
+	}
+
+	var problems []Problem
+
+	for _, types := range typesByName {
+		if len(types) < 2 {
+			continue
+		}
+
+		buildInfoOnce.Do(func() {
+			buildInfo, _ = debug.ReadBuildInfo()
+		})
+
+		sort.Slice(types, func(i, j int) bool {
+			return types[i].PkgPath() < types[j].PkgPath()
+		})
+
+		first := types[0]
+		firstMod := moduleOf(first.PkgPath(), buildInfo)
+		for _, t := range types[1:] {
+			// Packages like api and api/v2 in the same module are different
+			// types.
+			mod := moduleOf(t.PkgPath(), buildInfo)
+			if mod == firstMod {
+				continue
+			}
+
+			problems = append(problems, versionConflict(
+				first, firstMod, sets[first].(describer).describe(),
+				t, mod, sets[t].(describer).describe(),
+			))
+		}
+	}
+
+	return problems
+}
+
+// versionConflict returns the problem reported for type t (from module mod,
+// with the given values) being the same logical type as type other (from
+// module otherMod, with otherValues). The names of the enums that have
+// different IDs and the IDs of the enums that have different names in both
+// types are listed.
+func versionConflict(other reflect.Type, otherMod string, otherValues []ValueDescription,
+	t reflect.Type, mod string, values []ValueDescription,
+) Problem {
+	otherIDs := make(map[string]string, len(otherValues))
+	otherNames := make(map[string]string, len(otherValues))
+	for _, v := range otherValues {
+		otherIDs[v.Name] = v.ID
+		otherNames[v.ID] = v.Name
+	}
+
+	var conflicts []string
+	for _, v := range values {
+		if id, ok := otherIDs[v.Name]; ok && id != v.ID {
+			conflicts = append(conflicts, fmt.Sprintf("%s has ID %s in %s and %s in %s", v.Name, v.ID, mod, id, otherMod))
+		} else if name, ok := otherNames[v.ID]; ok && name != v.Name {
+			conflicts = append(conflicts, fmt.Sprintf("ID %s is %s in %s and %s in %s", v.ID, v.Name, mod, name, otherMod))
+		}
+	}
+
+	msg := fmt.Sprintf("same type as %s registered by a different module version (%s and %s), "+
+		"so its enums are registered twice", other, mod, otherMod)
+	if len(conflicts) > 0 {
+		msg += " with conflicting values: " + strings.Join(conflicts, "; ")
+	}
+
+	return Problem{
+		Type:    t,
+		Message: msg,
+	}
+}
@@ -0,0 +1,83 @@
+package enum
+
+import (
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+func TestLogicalPackagePath(t *testing.T) {
+	for pkgPath, expected := range map[string]string{
+		"example.com/roles":          "example.com/roles",
+		"example.com/roles/v2":       "example.com/roles",
+		"example.com/roles/v12/auth": "example.com/roles/auth",
+		"example.com/roles/v1":       "example.com/roles/v1",
+		"example.com/roles/v2beta":   "example.com/roles/v2beta",
+		"gopkg.in/roles.v3":          "gopkg.in/roles",
+	} {
+		if got := logicalPackagePath(pkgPath); got != expected {
+			t.Errorf("expected %s for %s, got %s", expected, pkgPath, got)
+		}
+	}
+}
+
+func TestModuleOf(t *testing.T) {
+	info := &debug.BuildInfo{
+		Main: debug.Module{Path: "example.com/app", Version: "(devel)"},
+		Deps: []*debug.Module{
+			{Path: "example.com/roles", Version: "v1.4.0"},
+			{Path: "example.com/roles/v2", Version: "v2.0.1"},
+		},
+	}
+
+	for pkgPath, expected := range map[string]string{
+		"example.com/app/internal": "example.com/app",
+		"example.com/roles":        "example.com/roles@v1.4.0",
+		"example.com/roles/v2/sub": "example.com/roles/v2@v2.0.1",
+		"example.com/rolesx":       "example.com/rolesx",
+	} {
+		if got := moduleOf(pkgPath, info); got != expected {
+			t.Errorf("expected %s for %s, got %s", expected, pkgPath, got)
+		}
+	}
+
+	if got := moduleOf("example.com/roles", nil); got != "example.com/roles" {
+		t.Errorf("expected the package path, got %s", got)
+	}
+}
+
+func TestVersionConflict(t *testing.T) {
+	type roleV1 int
+	type roleV2 int
+
+	v1 := []ValueDescription{{Name: "Admin", ID: "0"}, {Name: "User", ID: "1"}, {Name: "Guest", ID: "2"}}
+	v2 := []ValueDescription{{Name: "Admin", ID: "0"}, {Name: "Guest", ID: "1"}, {Name: "Owner", ID: "2"}}
+
+	p := versionConflict(reflect.TypeOf(roleV1(0)), "example.com/roles@v1.4.0", v1,
+		reflect.TypeOf(roleV2(0)), "example.com/roles/v2@v2.0.1", v2)
+
+	if p.Type != reflect.TypeOf(roleV2(0)) {
+		t.Errorf("unexpected type %s", p.Type)
+	}
+
+	for _, part := range []string{
+		"example.com/roles@v1.4.0",
+		"example.com/roles/v2@v2.0.1",
+		"Guest has ID 1 in example.com/roles/v2@v2.0.1 and 2 in example.com/roles@v1.4.0",
+		"ID 2 is Owner in example.com/roles/v2@v2.0.1 and Guest in example.com/roles@v1.4.0",
+	} {
+		if !strings.Contains(p.Message, part) {
+			t.Errorf("expected %q in %q", part, p.Message)
+		}
+	}
+
+	if strings.Contains(p.Message, "Admin") {
+		t.Errorf("unexpected conflict for Admin in %q", p.Message)
+	}
+
+	p = versionConflict(reflect.TypeOf(roleV1(0)), "a", v1, reflect.TypeOf(roleV2(0)), "b", v1)
+	if strings.Contains(p.Message, "conflicting") {
+		t.Errorf("unexpected conflicts in %q", p.Message)
+	}
+}
@@ -0,0 +1,48 @@
+package enum
+
+import (
+	"strings"
+
+	"golang.org/x/exp/constraints"
+	"golang.org/x/text/unicode/norm"
+)
+
+// SetNormalizer installs a function that normalizes names associated with
+// type T. It is applied to names when enums are created (so Name returns the
+// normalized name) and whenever names are looked up or decoded, so names
+// provided by users or containing Unicode compare consistently. Passing nil
+// removes the normalizer.
+//
+// It should be called before any enums of type T are created. If it is called
+// later, existing enums keep their names but are looked up by their normalized
+// names. This panics if two existing enums would have the same normalized
+// name.
+func SetNormalizer[T constraints.Integer](normalizer func(string) string) {
+	if err := getOrCreateSetForType[T]().SetNormalizer(normalizer); err != nil {
+		panic(err.Error())
+	}
+}
+
+// ComposeNormalizers returns a normalizer that applies all the given ones in
+// order.
+func ComposeNormalizers(normalizers ...func(string) string) func(string) string {
+	return func(name string) string {
+		for _, normalizer := range normalizers {
+			name = normalizer(name)
+		}
+
+		return name
+	}
+}
+
+// NormalizeWhitespace is a normalizer that removes leading and trailing white
+// space and replaces all other sequences of white space with a single space.
+func NormalizeWhitespace(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// NormalizeNFC is a normalizer that converts names to the Unicode Normalization
+// Form C (canonical composition).
+func NormalizeNFC(name string) string {
+	return norm.NFC.String(name)
+}
@@ -0,0 +1,63 @@
+package enum
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetNormalizer(t *testing.T) {
+	type city int
+
+	SetNormalizer[city](ComposeNormalizers(NormalizeWhitespace, NormalizeNFC))
+
+	// "São Paulo" with a decomposed "ã" and extra white space.
+	saoPaulo := New[city](" Sa\u0303o  Paulo ")
+
+	if saoPaulo.Name() != "S\u00e3o Paulo" {
+		t.Errorf("expected normalized name, got %q", saoPaulo.Name())
+	}
+
+	for _, name := range []string{"S\u00e3o Paulo", "Sa\u0303o Paulo", "\tS\u00e3o\nPaulo"} {
+		var e Enum[city]
+		if err := e.UnmarshalText([]byte(name)); err != nil {
+			t.Fatalf("unexpected error for %q: %s", name, err)
+		}
+		if e != saoPaulo {
+			t.Errorf("expected %s for %q, got %s", saoPaulo, name, e)
+		}
+	}
+
+	expectPanic(t, func() {
+		New[city]("S\u00e3o Paulo")
+	})
+}
+
+func TestSetNormalizer_Existing(t *testing.T) {
+	type color int
+
+	red := New[color]("Red")
+	New[color]("Blue")
+	New[color]("red2")
+
+	SetNormalizer[color](strings.ToLower)
+
+	e, err := EnumByTypeAndName[color]("RED")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if e != red {
+		t.Errorf("expected %s, got %s", red, e)
+	}
+
+	// Name is kept as registered.
+	if e.Name() != "Red" {
+		t.Errorf("expected name Red, got %s", e.Name())
+	}
+
+	expectPanic(t, func() {
+		// Same as Red2 after normalization.
+		SetNormalizer[color](func(s string) string {
+			return strings.TrimSuffix(strings.ToLower(s), "2")
+		})
+	})
+}
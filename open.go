@@ -0,0 +1,23 @@
+package enum
+
+import "golang.org/x/exp/constraints"
+
+// Open makes type T open: decoding a name that is not associated with any
+// enum of type T does not fail and, instead, dynamically adds an unrecognized
+// enum with that name (and the next available ID). Decoding the same name
+// again returns the same enum so unknown values round-trip exactly.
+// Unrecognized enums are not returned by EnumsByType and the number of them
+// that can be added is limited.
+//
+// This is useful when interoperating with third-party APIs that might add
+// values without notice. Types are closed by default.
+func Open[T constraints.Integer]() {
+	getOrCreateSetForType[T]().SetOpen(true)
+}
+
+// Closed makes type T closed (the default): decoding a name that is not
+// associated with any enum of type T fails. Unrecognized enums that were
+// already added are kept.
+func Closed[T constraints.Integer]() {
+	getOrCreateSetForType[T]().SetOpen(false)
+}
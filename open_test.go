@@ -0,0 +1,101 @@
+package enum
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+)
+
+type openStatus int
+
+var (
+	openActive   = New[openStatus]("Active")
+	openInactive = New[openStatus]("Inactive")
+)
+
+type closedStatus int
+
+var closedActive = New[closedStatus]("Active")
+
+func TestOpen(t *testing.T) {
+	Open[openStatus]()
+	defer Closed[openStatus]()
+
+	var e Enum[openStatus]
+	if err := json.Unmarshal([]byte(`"Suspended"`), &e); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !e.Unrecognized() {
+		t.Errorf("expected %s to be unrecognized", e)
+	}
+	if e.Name() != "Suspended" {
+		t.Errorf("expected name Suspended, got %s", e.Name())
+	}
+	if e == openActive || e == openInactive {
+		t.Errorf("expected a new enum, got %s", e)
+	}
+
+	// Decoding the same name again must return the same enum.
+	again, err := EnumByTypeAndName[openStatus]("Suspended")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if again != e {
+		t.Errorf("expected internalEnum pointer %p, got %p", e.internalEnum, again.internalEnum)
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(data) != `"Suspended"` {
+		t.Errorf("expected unrecognized name to round trip, got %s", data)
+	}
+
+	if openActive.Unrecognized() {
+		t.Errorf("expected %s to not be unrecognized", openActive)
+	}
+
+	for _, e := range EnumsByType[openStatus]() {
+		if e.Unrecognized() {
+			t.Errorf("expected unrecognized enum %s to not be listed", e)
+		}
+	}
+}
+
+func TestOpen_Concurrent(t *testing.T) {
+	Open[openStatus]()
+	defer Closed[openStatus]()
+
+	var wg sync.WaitGroup
+
+	results := make([]Enum[openStatus], 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			results[i], _ = EnumByTypeAndName[openStatus]("Pending")
+		}(i)
+	}
+
+	wg.Wait()
+
+	for _, e := range results {
+		if e != results[0] {
+			t.Errorf("expected internalEnum pointer %p, got %p", results[0].internalEnum, e.internalEnum)
+		}
+	}
+}
+
+func TestClosed(t *testing.T) {
+	var e Enum[closedStatus]
+	if err := json.Unmarshal([]byte(`"Suspended"`), &e); err == nil {
+		t.Fatalf("expected error, got %s", e)
+	}
+
+	if e, err := EnumByTypeAndName[closedStatus]("Active"); err != nil || e != closedActive {
+		t.Errorf("expected %s, got %v (%v)", closedActive, e, err)
+	}
+}
@@ -0,0 +1,63 @@
+package enum
+
+import "time"
+
+// Option configures an Enum when it is created with New.
+type Option func(*attributes)
+
+// attributes holds the optional, type-independent data associated with an
+// Enum.
+type attributes struct {
+	deprecated bool
+	stage      Lifecycle // Zero unless set with Stage.
+	groups     []string
+	tags       []string
+	metadata   map[string]string
+	loader     *metadataLoader
+	hashID     bool
+	parts      []string // Set for composite enums.
+
+	// validFrom and validUntil bound the period the Enum is active in. Zero
+	// values mean unbounded.
+	validFrom, validUntil time.Time
+
+	// requires holds the permissions (Enum[P] values) needed to use the Enum
+	// (see Requires).
+	requires []any
+
+	// registeredAt is the file:line of the call that registered the Enum.
+	registeredAt string
+}
+
+// Deprecated marks the Enum as deprecated. Deprecated Enums can still be used
+// normally but are rejected by ValidateStruct (see also Lifecycle).
+func Deprecated() Option {
+	return func(a *attributes) {
+		a.deprecated = true
+	}
+}
+
+// Group adds the Enum to the given groups. Groups partition the enums of a
+// type into logical subsets (for example, "staff" roles).
+func Group(groups ...string) Option {
+	return func(a *attributes) {
+		a.groups = append(a.groups, groups...)
+	}
+}
+
+// Tag associates the given free-form tags with the Enum.
+func Tag(tags ...string) Option {
+	return func(a *attributes) {
+		a.tags = append(a.tags, tags...)
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,171 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// OrUnknown holds either a registered Enum associated with type T or, when an
+// unrecognized name was decoded, the raw name itself. Unrecognized names are
+// encoded back as they were decoded, which allows passing through values that
+// are not known yet (similar to protobuf open enums), while registered Enums
+// are encoded like Enums are (see RejectDeprecated and CharEncoded). The zero
+// value holds neither and is not valid.
+type OrUnknown[T constraints.Integer] struct {
+	value Enum[T]
+	raw   string
+}
+
+// Known returns an OrUnknown holding the given Enum.
+func Known[E EnumType[T], T constraints.Integer](value E) OrUnknown[T] {
+	e := Enum[T](value)
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return OrUnknown[T]{value: e, raw: e.Name()}
+}
+
+// Unrecognized returns an OrUnknown holding the given raw name. If the name is
+// actually associated with a registered Enum, the Enum is used instead (or
+// its replacement if it was retired, see Retire) unless its lifecycle stage is
+// rejected (see RejectLifecycle).
+func Unrecognized[T constraints.Integer](raw string) OrUnknown[T] {
+	var o OrUnknown[T]
+	_ = o.set(raw)
+
+	return o
+}
+
+// set decodes the given raw name like Enums are decoded, except that names
+// that are not registered are kept as raw names even if type T is open (see
+// Open). This returns a non-nil error if the lifecycle stage of the Enum is
+// rejected, in which case only the raw name is kept.
+func (o *OrUnknown[T]) set(raw string) error {
+	o.raw = raw
+	o.value = Enum[T]{}
+
+	s, err := getSetForType[T]()
+	if err != nil {
+		return nil
+	}
+
+	s.MarkUsed()
+
+	e, ok, err := parseRegistered(s, raw)
+	if err != nil || !ok {
+		return err
+	}
+
+	o.value = Enum[T]{internalEnumWrapper[T]{e}}
+	o.raw = e.name
+
+	return nil
+}
+
+// Enum returns the associated Enum and true if the decoded name was
+// recognized. Otherwise it returns an invalid Enum and false.
+func (o OrUnknown[T]) Enum() (Enum[T], bool) {
+	return o.value, o.value.Valid()
+}
+
+// Known returns true if this holds a registered Enum.
+func (o OrUnknown[T]) Known() bool {
+	return o.value.Valid()
+}
+
+// Raw returns the name as it was decoded (or the Enum name for known values).
+func (o OrUnknown[T]) Raw() string {
+	return o.raw
+}
+
+// Valid returns true if this holds either a registered Enum or a raw name.
+func (o OrUnknown[T]) Valid() bool {
+	return o.value.Valid() || o.raw != ""
+}
+
+// String implements the fmt.Stringer interface.
+func (o OrUnknown[T]) String() string {
+	if !o.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return o.raw
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (o OrUnknown[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid() {
+		return nil, fmt.Errorf("enum not initialized")
+	}
+
+	if o.value.Valid() {
+		return o.value.MarshalJSON()
+	}
+
+	return json.Marshal(o.raw)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (o *OrUnknown[T]) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("source should be a string, got %s", data)
+	}
+
+	return o.UnmarshalText([]byte(name))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (o OrUnknown[T]) MarshalText() ([]byte, error) {
+	if !o.Valid() {
+		return nil, fmt.Errorf("enum not initialized")
+	}
+
+	if o.value.Valid() {
+		return o.value.MarshalText()
+	}
+
+	return []byte(o.raw), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (o *OrUnknown[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return fmt.Errorf("name cannot be empty")
+	}
+
+	return o.set(string(text))
+}
+
+// Value implements the driver.Valuer interface.
+func (o OrUnknown[T]) Value() (driver.Value, error) {
+	if !o.Valid() {
+		return nil, fmt.Errorf("enum not initialized")
+	}
+
+	if o.value.Valid() {
+		return o.value.Value()
+	}
+
+	return o.raw, nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OrUnknown[T]) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return o.UnmarshalText([]byte(v))
+	case []byte:
+		return o.UnmarshalText(v)
+	default:
+		return fmt.Errorf("value is not a string or byte slice")
+	}
+}
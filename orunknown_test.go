@@ -0,0 +1,139 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOrUnknown_Known(t *testing.T) {
+	var o OrUnknown[Role]
+	if err := json.Unmarshal([]byte(`"User"`), &o); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	e, ok := o.Enum()
+	if !ok || !o.Known() {
+		t.Fatalf("expected known value")
+	}
+	if RoleEnum(e) != User {
+		t.Errorf("expected %s, got %s", User, e)
+	}
+	if o.Raw() != "User" {
+		t.Errorf("expected raw User, got %s", o.Raw())
+	}
+
+	if o != Known(User) {
+		t.Errorf("expected %v, got %v", Known(User), o)
+	}
+}
+
+func TestOrUnknown_Unknown(t *testing.T) {
+	var o OrUnknown[Role]
+	if err := json.Unmarshal([]byte(`"SuperAdmin"`), &o); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := o.Enum(); ok || o.Known() {
+		t.Errorf("expected unknown value")
+	}
+	if !o.Valid() {
+		t.Errorf("expected valid value")
+	}
+	if o.Raw() != "SuperAdmin" {
+		t.Errorf("expected raw SuperAdmin, got %s", o.Raw())
+	}
+
+	data, err := json.Marshal(o)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(data) != `"SuperAdmin"` {
+		t.Errorf("expected raw value to round trip, got %s", data)
+	}
+
+	if o != Unrecognized[Role]("SuperAdmin") {
+		t.Errorf("expected %v, got %v", Unrecognized[Role]("SuperAdmin"), o)
+	}
+}
+
+func TestOrUnknown_Invalid(t *testing.T) {
+	var o OrUnknown[Role]
+
+	if o.Valid() {
+		t.Errorf("expected zero value to be invalid")
+	}
+	if _, err := json.Marshal(o); err == nil {
+		t.Errorf("expected error marshaling zero value")
+	}
+	if err := json.Unmarshal([]byte(`""`), &o); err == nil {
+		t.Errorf("expected error for empty name")
+	}
+	if err := json.Unmarshal([]byte(`1`), &o); err == nil {
+		t.Errorf("expected error for non-string value")
+	}
+}
+
+func TestOrUnknown_Lifecycle(t *testing.T) {
+	type feature int
+
+	New[feature]("Preview", Experimental())
+	export := New[feature]("Export")
+	imported := New[feature]("Imported")
+
+	Retire(imported, export)
+
+	Open[feature]()
+
+	var o OrUnknown[feature]
+	if err := json.Unmarshal([]byte(`"Imported"`), &o); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if e, ok := o.Enum(); !ok || e != export || o.Raw() != "Export" {
+		t.Errorf("expected the replacement %s, got %v (%s)", export, e, o.Raw())
+	}
+
+	RejectLifecycle[feature](LifecycleExperimental)
+
+	if err := json.Unmarshal([]byte(`"Preview"`), &o); err == nil {
+		t.Errorf("expected Preview to be rejected")
+	}
+
+	if o.Known() || o.Raw() != "Preview" {
+		t.Errorf("expected only the raw name, got %v", o)
+	}
+
+	// Unknown names are not added to open types.
+	if err := json.Unmarshal([]byte(`"Upcoming"`), &o); err != nil || o.Known() {
+		t.Errorf("expected an unknown value, got %v (%v)", o, err)
+	}
+
+	if _, err := getInternalEnumForName[feature]("Upcoming"); err == nil {
+		t.Errorf("expected Upcoming not to be registered")
+	}
+}
+
+func TestOrUnknown_MarshalPolicies(t *testing.T) {
+	type orUnknownStatus int
+
+	legacy := New[orUnknownStatus]("Legacy", Deprecated())
+	RejectDeprecated[orUnknownStatus]()
+
+	o := Known(legacy)
+
+	if _, err := json.Marshal(o); err == nil {
+		t.Errorf("expected error marshaling a rejected deprecated enum")
+	}
+
+	if _, err := o.MarshalText(); err == nil {
+		t.Errorf("expected error marshaling a rejected deprecated enum")
+	}
+
+	if _, err := o.Value(); err == nil {
+		t.Errorf("expected error marshaling a rejected deprecated enum")
+	}
+
+	if data, err := json.Marshal(Known(debit)); err != nil || string(data) != `"D"` {
+		t.Errorf("expected \"D\", got %s (%v)", data, err)
+	}
+}
@@ -0,0 +1,111 @@
+package enum
+
+import (
+	"fmt"
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// PackedWidth returns the number of bits needed to represent the ID of any
+// enum currently registered for type T (at least 1). Unrecognized enums of
+// open types (see Open) are not counted, so the width does not change when
+// unknown names are decoded. As it depends on the registered enums, it should
+// only be used after all of them are registered and data packed with a given
+// width must be unpacked with the same width.
+func PackedWidth[T constraints.Integer]() int {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return 1
+	}
+
+	maxID, ok := s.MaxID()
+	if !ok || maxID <= 0 {
+		return 1
+	}
+
+	return bits.Len64(uint64(maxID))
+}
+
+// Pack packs the IDs of the given enums into a bitfield using PackedWidth bits
+// per enum. IDs are stored in order starting from the least significant bit of
+// the first byte. Enums are packed as they are marshaled (see
+// RejectDeprecated). Enums with negative IDs and unrecognized enums can not be
+// packed.
+func Pack[T constraints.Integer](values ...Enum[T]) ([]byte, error) {
+	width := PackedWidth[T]()
+
+	data := make([]byte, (len(values)*width+7)/8)
+
+	for i, v := range values {
+		if !v.Valid() {
+			return nil, fmt.Errorf("enum at index %d not initialized", i)
+		}
+
+		m, err := v.marshaled()
+		if err != nil {
+			return nil, fmt.Errorf("enum at index %d: %w", i, err)
+		}
+
+		if m.unrecognized {
+			return nil, fmt.Errorf("enum %s at index %d is unrecognized", m.name, i)
+		}
+
+		id := m.id
+		if id < 0 {
+			return nil, fmt.Errorf("enum %s at index %d has negative id %d", m.name, i, id)
+		}
+
+		putBits(data, i*width, width, uint64(id))
+	}
+
+	return data, nil
+}
+
+// Unpack returns the n enums packed in the given data by Pack. Retired enums
+// are resolved to their replacements (see Retire).
+func Unpack[T constraints.Integer](data []byte, n int) ([]Enum[T], error) {
+	width := PackedWidth[T]()
+
+	// Comparing with the number of values that fit avoids overflowing n*width.
+	if n < 0 || n > len(data)*8/width {
+		return nil, fmt.Errorf("data too short for %d enums of %d bits", n, width)
+	}
+
+	values := make([]Enum[T], n)
+	for i := range values {
+		id := T(getBits(data, i*width, width))
+
+		e, err := getInternalEnumForID(id)
+		if err != nil {
+			return nil, fmt.Errorf("enum at index %d: %w", i, err)
+		}
+
+		values[i] = Enum[T]{internalEnumWrapper[T]{e.set.Resolve(e)}}
+	}
+
+	return values, nil
+}
+
+// putBits stores the lowest width bits of v in data starting at bit offset.
+func putBits(data []byte, offset, width int, v uint64) {
+	for i := 0; i < width; i++ {
+		if v&(1<<i) != 0 {
+			bit := offset + i
+			data[bit/8] |= 1 << (bit % 8)
+		}
+	}
+}
+
+// getBits returns width bits from data starting at bit offset.
+func getBits(data []byte, offset, width int) uint64 {
+	var v uint64
+	for i := 0; i < width; i++ {
+		bit := offset + i
+		if data[bit/8]&(1<<(bit%8)) != 0 {
+			v |= 1 << i
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,110 @@
+package enum
+
+import "testing"
+
+func TestPackedWidth(t *testing.T) {
+	// Role IDs go from 0 to 3.
+	if w := PackedWidth[Role](); w != 2 {
+		t.Errorf("expected width 2, got %d", w)
+	}
+
+	type noEnums int
+	if w := PackedWidth[noEnums](); w != 1 {
+		t.Errorf("expected width 1, got %d", w)
+	}
+}
+
+func TestPackUnpack(t *testing.T) {
+	values := []Enum[Role]{
+		Enum[Role](Guest),
+		Enum[Role](UnknownRole),
+		Enum[Role](User),
+		Enum[Role](Admin),
+		Enum[Role](Guest),
+	}
+
+	data, err := Pack(values...)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// 5 values of 2 bits each.
+	if len(data) != 2 {
+		t.Fatalf("expected 2 bytes, got %d", len(data))
+	}
+	if data[0] != 0b01_10_00_11 || data[1] != 0b11 {
+		t.Errorf("unexpected packed data %08b", data)
+	}
+
+	unpacked, err := Unpack[Role](data, len(values))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for i := range values {
+		if unpacked[i] != values[i] {
+			t.Errorf("expected %s at index %d, got %s", values[i], i, unpacked[i])
+		}
+	}
+
+	if _, err := Unpack[Role](data, 9); err == nil {
+		t.Errorf("expected error for short data")
+	}
+	if _, err := Pack(Enum[Role]{}); err == nil {
+		t.Errorf("expected error for invalid enum")
+	}
+}
+
+func TestPackUnpack_Policies(t *testing.T) {
+	type packStatus int
+
+	active := New[packStatus]("Active")
+	old := New[packStatus]("Old", Deprecated())
+	legacy := New[packStatus]("Legacy", Deprecated())
+	Retire(old, active)
+
+	// Data packed before old was retired.
+	data := make([]byte, 1)
+	putBits(data, 0, PackedWidth[packStatus](), uint64(old.ID()))
+
+	unpacked, err := Unpack[packStatus](data, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if unpacked[0] != active {
+		t.Errorf("expected the replacement %s, got %s", active, unpacked[0])
+	}
+
+	RejectDeprecated[packStatus]()
+
+	if _, err := Pack(legacy); err == nil {
+		t.Errorf("expected error packing a rejected deprecated enum")
+	}
+
+	if _, err := Unpack[packStatus](data, int(^uint(0)>>1)); err == nil {
+		t.Errorf("expected error for a huge count")
+	}
+}
+
+func TestPackedWidth_Open(t *testing.T) {
+	type packOpen int
+
+	New[packOpen]("A")
+	New[packOpen]("B")
+	Open[packOpen]()
+
+	for _, name := range []string{"C", "D", "E", "F"} {
+		var e Enum[packOpen]
+		if err := e.UnmarshalText([]byte(name)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if _, err := Pack(e); err == nil {
+			t.Errorf("expected error packing unrecognized %s", name)
+		}
+	}
+
+	if w := PackedWidth[packOpen](); w != 1 {
+		t.Errorf("expected width 1, got %d", w)
+	}
+}
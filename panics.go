@@ -0,0 +1,90 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// packagePrefix prefixes the names of the functions of this package in stack
+// frames.
+var packagePrefix = reflect.TypeOf(attributes{}).PkgPath() + "."
+
+// externalCaller returns the file:line of the first caller outside of this
+// package, skipping compiler generated wrappers (for methods promoted to
+// types derived from Enum[T]), or an empty string if none is found.
+func externalCaller() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, packagePrefix) && frame.File != "<autogenerated>" {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+// notInitialized returns the value of panics for uninitialized enums of type
+// T. It includes the call site of the caller outside of this package and,
+// with the enumdebug build tag (see debug.go), the full stack trace.
+func notInitialized[T constraints.Integer]() string {
+	msg := fmt.Sprintf("enum not initialized (zero value of an enum of type %s)", getTypeName[T]())
+	if site := externalCaller(); site != "" {
+		msg += " used at " + site
+	}
+
+	if debugMode {
+		msg += "\n\n" + string(debug.Stack())
+	}
+
+	return msg
+}
+
+// panicNotInitialized panics with notInitialized. It is kept out of line so
+// the accessors that call it (ID, Name and String) stay cheap enough to be
+// inlined by the compiler.
+//
+//go:noinline
+func panicNotInitialized[T constraints.Integer]() {
+	panic(notInitialized[T]())
+}
+
+// emptyName returns the value of panics for enums of type T registered with
+// an empty name at the given call site.
+func emptyName[T constraints.Integer](registeredAt string) string {
+	msg := fmt.Sprintf("enum name cannot be empty (type %s", getTypeName[T]())
+	if registeredAt != "" {
+		msg += ", registered at " + registeredAt
+	}
+
+	return msg + ")"
+}
+
+// registrationFailure returns the value of panics for enums that can not be
+// registered: the given message followed by the type, the name of the enum,
+// the number of enums already registered (out of the number of distinct
+// values of type T) and the call site. It must be called with the lock held.
+func (s *internalSet[T]) registrationFailure(msg, name string, attrs attributes) string {
+	details := []string{"type " + getTypeName[T](), fmt.Sprintf("name %q", name)}
+
+	if limit := maxEnums[T](); limit > 0 {
+		details = append(details, fmt.Sprintf("%d of %d possible enums registered", len(s.nameEnumMap), limit))
+	} else {
+		details = append(details, fmt.Sprintf("%d enums registered", len(s.nameEnumMap)))
+	}
+
+	if attrs.registeredAt != "" {
+		details = append(details, "registered at "+attrs.registeredAt)
+	}
+
+	return msg + " [" + strings.Join(details, ", ") + "]"
+}
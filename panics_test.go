@@ -0,0 +1,109 @@
+package enum
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// panicMessage returns the message of the panic raised by f, or an empty
+// string if it does not panic.
+func panicMessage(f func()) (msg string) {
+	defer func() {
+		if r := recover(); r != nil {
+			msg = fmt.Sprint(r)
+		}
+	}()
+
+	f()
+
+	return ""
+}
+
+func expectContains(t *testing.T, msg string, parts ...string) {
+	t.Helper()
+
+	for _, part := range parts {
+		if !strings.Contains(msg, part) {
+			t.Errorf("expected %q in panic message %q", part, msg)
+		}
+	}
+}
+
+func TestPanic_Duplicate(t *testing.T) {
+	type duplicated uint8
+
+	New[duplicated]("First")
+	_, file, firstLine, _ := runtime.Caller(0)
+
+	msg := panicMessage(func() {
+		New[duplicated]("First")
+	})
+
+	expectContains(t, msg,
+		"duplicate name in enum set",
+		getTypeName[duplicated](),
+		`name "First"`,
+		"1 of 256 possible enums registered",
+		fmt.Sprintf("%s:%d", filepath.Base(file), firstLine-1),
+		fmt.Sprintf("%s:%d", filepath.Base(file), firstLine+3),
+	)
+
+	msg = panicMessage(func() {
+		NewWithID[duplicated]("Second", 0)
+	})
+
+	expectContains(t, msg, "duplicate id 0 in enum set", `name "Second"`)
+}
+
+func TestPanic_Overflow(t *testing.T) {
+	type small uint8
+
+	for i := 0; i < 256; i++ {
+		New[small](fmt.Sprintf("V%d", i))
+	}
+
+	msg := panicMessage(func() {
+		New[small]("Extra")
+	})
+
+	expectContains(t, msg, "too many enums in enum set", getTypeName[small](), `name "Extra"`, "256 of 256 possible enums registered")
+}
+
+func TestPanic_NotInitialized(t *testing.T) {
+	var e Enum[Role]
+
+	msg := panicMessage(func() {
+		e.Name()
+	})
+
+	expectContains(t, msg, "enum not initialized", getTypeName[Role](), "used at ")
+}
+
+func TestPanic_EmptyName(t *testing.T) {
+	type unnamed int
+
+	msg := panicMessage(func() {
+		New[unnamed]("")
+	})
+
+	expectContains(t, msg, "enum name cannot be empty", getTypeName[unnamed](), "panics_test.go:")
+}
+
+func TestPanic_Composite(t *testing.T) {
+	type composite int
+
+	msg := panicMessage(func() {
+		NewComposite[composite]([]string{"billing", ""})
+	})
+
+	expectContains(t, msg, `invalid composite enum part ""`, getTypeName[composite](), `name "billing:"`, "0 enums registered", "panics_test.go:")
+
+	msg = panicMessage(func() {
+		NewComposite[composite](nil)
+	})
+
+	expectContains(t, msg, "composite enum must have at least one part", getTypeName[composite](), "panics_test.go:")
+}
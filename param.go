@@ -0,0 +1,88 @@
+package enum
+
+import (
+	"errors"
+	"reflect"
+)
+
+// UnmarshalParam decodes the given query, form or path parameter like
+// UnmarshalText. It implements the BindUnmarshaler interfaces of Gin (version
+// 1.10 and later) and Echo, so Enums bind from request parameters in those
+// frameworks. Unlike UnmarshalText, the returned error is an
+// *InvalidValueError listing the allowed values (see NewInvalidValues).
+func (e *internalEnumWrapper[T]) UnmarshalParam(param string) error {
+	ie, err := parseInternalEnum[T](param)
+	if err != nil {
+		return &InvalidValueError{
+			Type:    reflect.TypeOf(Enum[T]{}),
+			Input:   param,
+			Allowed: e.names(),
+			Err:     err,
+		}
+	}
+
+	e.internalEnum = ie
+
+	return nil
+}
+
+// InvalidValues is the body of an error response for a request with enum
+// values that can not be decoded, usually sent with status 422
+// (Unprocessable Entity). It is encoded to JSON as:
+//
+//	{
+//		"message": "invalid enum values",
+//		"fields": [
+//			{"field": "/role", "value": "\"Root\"", "allowed": ["Admin", "User"]}
+//		]
+//	}
+type InvalidValues struct {
+	Message string         `json:"message"`
+	Fields  []InvalidField `json:"fields"`
+}
+
+// InvalidField describes a value in InvalidValues.
+type InvalidField struct {
+	// Field is the path of the value (see FieldError), which is empty if
+	// unknown (for example, for request parameters).
+	Field string `json:"field"`
+
+	// Value is the value, as found in the input.
+	Value string `json:"value"`
+
+	// Allowed are the names of the enums the value can be decoded into.
+	Allowed []string `json:"allowed"`
+}
+
+// NewInvalidValues returns the body of an error response for err if it is (or
+// wraps) a *InvalidValueError or a FieldErrors holding at least one, as
+// returned by UnmarshalParam, DecodeJSON and Tagged. The other errors of a
+// FieldErrors are not included. This returns false for other errors.
+func NewInvalidValues(err error) (*InvalidValues, bool) {
+	var fields []InvalidField
+
+	var errs FieldErrors
+	if errors.As(err, &errs) {
+		for _, fe := range errs {
+			var ive *InvalidValueError
+			if errors.As(fe.Err, &ive) {
+				fields = append(fields, InvalidField{fe.Path, ive.Input, ive.Allowed})
+			}
+		}
+	} else {
+		var fe *FieldError
+		var ive *InvalidValueError
+		switch {
+		case errors.As(err, &fe) && errors.As(fe.Err, &ive):
+			fields = append(fields, InvalidField{fe.Path, ive.Input, ive.Allowed})
+		case errors.As(err, &ive):
+			fields = append(fields, InvalidField{"", ive.Input, ive.Allowed})
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil, false
+	}
+
+	return &InvalidValues{Message: "invalid enum values", Fields: fields}, true
+}
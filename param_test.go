@@ -0,0 +1,82 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalParam(t *testing.T) {
+	type level int
+
+	var (
+		low  = New[level]("Low")
+		high = New[level]("High")
+	)
+
+	var e Enum[level]
+	if err := e.UnmarshalParam("High"); err != nil || e != high {
+		t.Errorf("expected %s, got %v (%v)", high, e, err)
+	}
+
+	err := e.UnmarshalParam("Medium")
+
+	var ive *InvalidValueError
+	if !errors.As(err, &ive) {
+		t.Fatalf("expected an InvalidValueError, got %v", err)
+	}
+
+	if ive.Input != "Medium" || !reflect.DeepEqual(ive.Allowed, []string{"Low", "High"}) || ive.Type != reflect.TypeOf(low) {
+		t.Errorf("unexpected error %+v", ive)
+	}
+
+	if e != high {
+		t.Errorf("expected %s to be unchanged, got %s", high, e)
+	}
+}
+
+func TestNewInvalidValues(t *testing.T) {
+	type level int
+
+	New[level]("Low")
+	New[level]("High")
+
+	var req struct {
+		Level  Enum[level] `json:"level"`
+		Levels []Enum[level]
+	}
+
+	err := DecodeJSON([]byte(`{"level": "Medium", "Levels": ["Low", "Top"]}`), &req)
+
+	v, ok := NewInvalidValues(fmt.Errorf("decoding request: %w", err))
+	if !ok {
+		t.Fatalf("expected invalid values for %v", err)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"message":"invalid enum values","fields":[` +
+		`{"field":"/Levels/1","value":"\"Top\"","allowed":["Low","High"]},` +
+		`{"field":"/level","value":"\"Medium\"","allowed":["Low","High"]}]}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+
+	var e Enum[level]
+	if v, ok := NewInvalidValues(e.UnmarshalParam("Top")); !ok || len(v.Fields) != 1 || v.Fields[0].Field != "" || v.Fields[0].Value != "Top" {
+		t.Errorf("unexpected invalid values %+v", v)
+	}
+
+	if _, ok := NewInvalidValues(errors.New("other")); ok {
+		t.Errorf("expected no invalid values for other errors")
+	}
+
+	if _, ok := NewInvalidValues(FieldErrors{{Path: "/a", Err: errors.New("other")}}); ok {
+		t.Errorf("expected no invalid values for other field errors")
+	}
+}
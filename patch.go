@@ -0,0 +1,249 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/bruno-ga/enum/internal/jsonpath"
+)
+
+// FieldError is an error associated with a specific field.
+type FieldError struct {
+	// Path identifies the field. Its format depends on the function that
+	// returned the error.
+	Path string
+
+	Err error
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// FieldErrors is a list of errors associated with fields. It is returned by
+// functions that report all invalid fields at once.
+type FieldErrors []*FieldError
+
+// Error implements the error interface.
+func (e FieldErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Error())
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the individual errors.
+func (e FieldErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, fe := range e {
+		errs = append(errs, fe)
+	}
+
+	return errs
+}
+
+// ValidateMergePatch validates all enum values in the given JSON Merge Patch
+// (RFC 7396) document against the enum fields of target, which must be a
+// struct or a pointer to one. Only the type of target is used and it is never
+// modified. Null values are accepted as they remove the associated field.
+//
+// If any values are invalid, a FieldErrors is returned with paths in JSON
+// Pointer (RFC 6901) format.
+func ValidateMergePatch(target any, patch []byte) error {
+	var doc any
+	if err := json.Unmarshal(patch, &doc); err != nil {
+		return fmt.Errorf("invalid merge patch: %w", err)
+	}
+
+	var errs FieldErrors
+	validateJSONValue(reflect.TypeOf(target), doc, "", true, &errs)
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+type jsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// ValidateJSONPatch validates all enum values in the given JSON Patch
+// (RFC 6902) document against the enum fields of target, which must be a
+// struct or a pointer to one. Only the type of target is used and it is never
+// modified. Values of add, replace and test operations are validated and move
+// and copy operations are only allowed between fields of the same enum type.
+//
+// If any values are invalid, a FieldErrors is returned with the operation
+// paths.
+func ValidateJSONPatch(target any, patch []byte) error {
+	var ops []jsonPatchOperation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return fmt.Errorf("invalid json patch: %w", err)
+	}
+
+	t := reflect.TypeOf(target)
+
+	var errs FieldErrors
+	for _, op := range ops {
+		pathType := typeForJSONPointer(t, op.Path)
+		if pathType == nil {
+			// Not something we know about.
+			continue
+		}
+
+		switch op.Op {
+		case "add", "replace", "test":
+			var value any
+			if err := json.Unmarshal(op.Value, &value); err != nil {
+				errs = append(errs, &FieldError{op.Path, fmt.Errorf("invalid value: %w", err)})
+				continue
+			}
+
+			validateJSONValue(pathType, value, op.Path, false, &errs)
+		case "move", "copy":
+			if !isEnumType(pathType) {
+				continue
+			}
+
+			if fromType := typeForJSONPointer(t, op.From); fromType != pathType {
+				errs = append(errs, &FieldError{op.Path, fmt.Errorf("cannot %s %s into a %s field", op.Op, op.From, pathType)})
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// validateJSONValue recursively validates the decoded JSON value v against
+// type t, adding an error for every enum value that can not be decoded.
+func validateJSONValue(t reflect.Type, v any, path string, allowNull bool, errs *FieldErrors) {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == nil {
+		return
+	}
+
+	if isEnumType(t) {
+		if v == nil && allowNull {
+			return
+		}
+
+		data, err := json.Marshal(v)
+		if err == nil {
+			target := reflect.New(t).Interface()
+			if err = target.(json.Unmarshaler).UnmarshalJSON(data); err != nil {
+				err = &InvalidValueError{
+					Type:    t,
+					Input:   string(data),
+					Allowed: target.(enumDecoder).names(),
+					Err:     err,
+				}
+			}
+		}
+		if err != nil {
+			*errs = append(*errs, &FieldError{path, err})
+		}
+
+		return
+	}
+
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		// Types with custom JSON handling are opaque to us.
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return
+		}
+
+		for key, value := range obj {
+			if f, ok := jsonpath.Field(t, key); ok {
+				validateJSONValue(f.Type, value, path+"/"+jsonpath.Escape(key), allowNull, errs)
+			}
+		}
+	case reflect.Map:
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return
+		}
+
+		for key, value := range obj {
+			validateJSONValue(t.Elem(), value, path+"/"+jsonpath.Escape(key), allowNull, errs)
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := v.([]any)
+		if !ok {
+			return
+		}
+
+		for i, value := range arr {
+			validateJSONValue(t.Elem(), value, path+"/"+strconv.Itoa(i), false, errs)
+		}
+	}
+}
+
+// typeForJSONPointer returns the type that the given JSON Pointer references
+// starting from type t or nil if it can not be determined.
+func typeForJSONPointer(t reflect.Type, pointer string) reflect.Type {
+	if pointer == "" {
+		return t
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil
+	}
+
+	for _, token := range strings.Split(pointer[1:], "/") {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+
+		token = jsonpath.Unescape(token)
+
+		switch t.Kind() {
+		case reflect.Struct:
+			f, ok := jsonpath.Field(t, token)
+			if !ok {
+				return nil
+			}
+
+			t = f.Type
+		case reflect.Map:
+			t = t.Elem()
+		case reflect.Slice, reflect.Array:
+			// Any index or "-" (the end of the array).
+			t = t.Elem()
+		default:
+			return nil
+		}
+	}
+
+	return t
+}
@@ -0,0 +1,109 @@
+package enum
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+type patchMember struct {
+	Role RoleEnum `json:"role"`
+}
+
+type patchTarget struct {
+	Name        string           `json:"name"`
+	Role        RoleEnum         `json:"role"`
+	Permissions []PermissionEnum `json:"permissions"`
+	Owner       *patchMember     `json:"owner"`
+	Members     []patchMember    `json:"members"`
+	ByName      map[string]RoleEnum
+}
+
+func fieldErrorPaths(t *testing.T, err error) []string {
+	t.Helper()
+
+	var errs FieldErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected FieldErrors, got %v", err)
+	}
+
+	paths := make([]string, 0, len(errs))
+	for _, fe := range errs {
+		paths = append(paths, fe.Path)
+	}
+
+	return paths
+}
+
+func TestValidateMergePatch(t *testing.T) {
+	valid := `{
+		"name": "x",
+		"role": "Admin",
+		"permissions": ["Read", "Write"],
+		"owner": {"role": null},
+		"members": [{"role": "User"}],
+		"byname": {"a": "Guest"},
+		"unknown": "Root"
+	}`
+	if err := ValidateMergePatch(&patchTarget{}, []byte(valid)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := ValidateMergePatch(patchTarget{}, []byte(`{"role": null}`)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	invalid := `{"role": "Root", "members": [{"role": "User"}, {"role": 1}]}`
+
+	err := ValidateMergePatch(&patchTarget{}, []byte(invalid))
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	paths := fieldErrorPaths(t, err)
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 errors, got %v", paths)
+	}
+	for _, path := range []string{"/role", "/members/1/role"} {
+		if !slices.Contains(paths, path) {
+			t.Errorf("expected error for %s, got %v", path, paths)
+		}
+	}
+}
+
+func TestValidateJSONPatch(t *testing.T) {
+	valid := `[
+		{"op": "replace", "path": "/role", "value": "Admin"},
+		{"op": "add", "path": "/permissions/-", "value": "Read"},
+		{"op": "add", "path": "/members/0", "value": {"role": "Guest"}},
+		{"op": "copy", "from": "/owner/role", "path": "/role"},
+		{"op": "remove", "path": "/owner"},
+		{"op": "replace", "path": "/name", "value": "Admin"}
+	]`
+	if err := ValidateJSONPatch(&patchTarget{}, []byte(valid)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	invalid := `[
+		{"op": "replace", "path": "/role", "value": "Root"},
+		{"op": "add", "path": "/permissions/0", "value": "Execute"},
+		{"op": "replace", "path": "/owner", "value": {"role": null}},
+		{"op": "move", "from": "/name", "path": "/role"}
+	]`
+
+	err := ValidateJSONPatch(&patchTarget{}, []byte(invalid))
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	paths := fieldErrorPaths(t, err)
+	if len(paths) != 4 {
+		t.Fatalf("expected 4 errors, got %v", paths)
+	}
+	for _, path := range []string{"/role", "/permissions/0", "/owner/role"} {
+		if !slices.Contains(paths, path) {
+			t.Errorf("expected error for %s, got %v", path, paths)
+		}
+	}
+}
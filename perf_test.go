@@ -0,0 +1,133 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// allocBudgets holds the number of allocations the hot paths are allowed to
+// make. String, ID and lookups of registered names and IDs must not allocate
+// and marshaling only allocates the returned slice.
+var allocBudgets = []struct {
+	name   string
+	budget float64
+	f      func()
+}{
+	{"String", 0, func() { _ = Admin.String() }},
+	{"Name", 0, func() { _ = Admin.Name() }},
+	{"ID", 0, func() { _ = Admin.ID() }},
+	{"Parse", 0, func() { _, _ = EnumByTypeAndName[Role]("Admin") }},
+	{"FromID", 0, func() { _, _ = FromID[Role](1) }},
+	{"MarshalText", 1, func() { _, _ = Admin.MarshalText() }},
+	{"UnmarshalText", 0, func() {
+		var r RoleEnum
+		_ = r.UnmarshalText(adminText)
+	}},
+	{"MarshalJSON", 1, func() { _, _ = Admin.MarshalJSON() }},
+	{"UnmarshalJSON", 0, func() {
+		var r RoleEnum
+		_ = r.UnmarshalJSON(adminJSON)
+	}},
+}
+
+var (
+	adminText = []byte("Admin")
+	adminJSON = []byte(`"Admin"`)
+)
+
+func TestAllocations(t *testing.T) {
+	for _, b := range allocBudgets {
+		if allocs := testing.AllocsPerRun(100, b.f); allocs > b.budget {
+			t.Errorf("%s: expected at most %v allocations, got %v", b.name, b.budget, allocs)
+		}
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = Admin.String()
+	}
+}
+
+func BenchmarkID(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = Admin.ID()
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := EnumByTypeAndName[Role]("Admin"); err != nil {
+			b.Fatalf("unexpected error: %s", err)
+		}
+	}
+}
+
+func BenchmarkFromID(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := FromID[Role](1); err != nil {
+			b.Fatalf("unexpected error: %s", err)
+		}
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := Admin.MarshalJSON(); err != nil {
+			b.Fatalf("unexpected error: %s", err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	b.ReportAllocs()
+
+	var r RoleEnum
+	for i := 0; i < b.N; i++ {
+		if err := r.UnmarshalJSON(adminJSON); err != nil {
+			b.Fatalf("unexpected error: %s", err)
+		}
+	}
+}
+
+func BenchmarkJSONStruct(b *testing.B) {
+	b.ReportAllocs()
+
+	type user struct {
+		Role RoleEnum `json:"role"`
+	}
+
+	data := []byte(`{"role":"Admin"}`)
+	for i := 0; i < b.N; i++ {
+		var u user
+		if err := json.Unmarshal(data, &u); err != nil {
+			b.Fatalf("unexpected error: %s", err)
+		}
+	}
+}
+
+func TestAppendJSONString(t *testing.T) {
+	for _, s := range []string{"", "Admin", "a\"b", "a\\b", "<tag>", "a&b", "tab\there", "S\u00e3o Paulo", " "} {
+		expected, _ := json.Marshal(s)
+		if data := appendJSONString(nil, s); string(data) != string(expected) {
+			t.Errorf("expected %s, got %s", expected, data)
+		}
+	}
+}
+
+func TestUnmarshalJSON_Escaped(t *testing.T) {
+	var r RoleEnum
+	if err := r.UnmarshalJSON([]byte(`"\u0041dmin"`)); err != nil || r != Admin {
+		t.Errorf("expected %s, got %v (%v)", Admin, r, err)
+	}
+}
@@ -0,0 +1,131 @@
+package enum
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Registration is an intended registration of an enum associated with type
+// T, validated by Plan. It is returned by Planned and PlannedWithID.
+type Registration[T constraints.Integer] struct {
+	name  string
+	id    *T
+	attrs attributes
+}
+
+// Planned returns the registration New would make with the given arguments.
+func Planned[T constraints.Integer](name string, opts ...Option) Registration[T] {
+	return planned[T](name, nil, opts, callSite(1))
+}
+
+// PlannedWithID returns the registration NewWithID would make with the given
+// arguments.
+func PlannedWithID[T constraints.Integer](name string, id T, opts ...Option) Registration[T] {
+	return planned(name, &id, opts, callSite(1))
+}
+
+func planned[T constraints.Integer](name string, id *T, opts []Option, registeredAt string) Registration[T] {
+	attrs := attributes{registeredAt: registeredAt}
+	for _, opt := range opts {
+		opt(&attrs)
+	}
+
+	return Registration[T]{name, id, attrs}
+}
+
+// Plan checks whether the given registrations, made in order, would succeed
+// without making them, so code registering many enums at once (like code
+// generated by enumgen or plugin loaders) can check all of them before
+// modifying the registry. It returns a problem for every registration that
+// would panic (for example, because of a duplicate name or ID, a type that
+// is frozen or out of IDs or an ID reserved by RequireUnknownZero or not
+// allowed by RequireContiguous) with the message of the panic, or nil if all
+// of them would succeed:
+//
+//	registrations := []enum.Registration[Role]{
+//		enum.Planned[Role]("Admin"),
+//		enum.PlannedWithID[Role]("Guest", 10),
+//	}
+//
+//	if problems := enum.Plan(registrations...); len(problems) > 0 {
+//		return fmt.Errorf("invalid plugin roles: %v", problems)
+//	}
+//
+// Registrations that would fail are skipped when checking the next ones. The
+// result is only accurate as long as no other enums of type T are registered
+// in the meantime.
+func Plan[T constraints.Integer](registrations ...Registration[T]) []Problem {
+	var scratch *internalSet[T]
+	if s, err := getSetForType[T](); err == nil {
+		scratch = s.clone()
+	} else {
+		scratch = newInternalSet[T]()
+	}
+
+	var problems []Problem
+
+	for _, r := range registrations {
+		if msg := scratch.tryRegister(r); msg != "" {
+			problems = append(problems, Problem{
+				Type:    getType[T](),
+				Name:    r.name,
+				Message: msg,
+			})
+		}
+	}
+
+	return problems
+}
+
+// tryRegister registers the given registration in the set, returning the
+// value of the panic it causes if it fails.
+func (s *internalSet[T]) tryRegister(r Registration[T]) (msg string) {
+	if r.name == "" {
+		return emptyName[T](r.attrs.registeredAt)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			msg = fmt.Sprint(v)
+		}
+	}()
+
+	id := r.id
+	if id == nil && r.attrs.hashID {
+		hashed := hashID[T](r.name)
+		id = &hashed
+	}
+
+	s.register(r.name, r.attrs, id, nil)
+
+	return ""
+}
+
+// clone returns a copy of the set that can be registered into without
+// modifying it. Only the state used by register is copied.
+func (s *internalSet[T]) clone() *internalSet[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	c := newInternalSet[T]()
+
+	for name, e := range s.nameEnumMap {
+		c.nameEnumMap[name] = e
+	}
+
+	for id, e := range s.idEnumMap {
+		c.idEnumMap[id] = e
+	}
+
+	c.enums = append(c.enums, s.enums...)
+	c.normalizer = s.normalizer
+	c.used = atomic.LoadUint32(&s.used)
+	c.nextID, c.exhaustedID = s.nextID, s.exhaustedID
+	c.unrecognized = s.unrecognized
+	c.frozen, c.rejectLate, c.explicitIDs = s.frozen, s.rejectLate, s.explicitIDs
+	c.gaps, c.unknownName = s.gaps, s.unknownName
+
+	return c
+}
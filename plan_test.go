@@ -0,0 +1,88 @@
+package enum
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPlan(t *testing.T) {
+	type plugin uint8
+
+	New[plugin]("Core")
+	RequireUnknownZero[plugin]("Core")
+
+	problems := Plan(
+		Planned[plugin]("Auth"),
+		Planned[plugin]("Core"),
+		PlannedWithID[plugin]("Billing", 1),
+		PlannedWithID[plugin]("Search", 0),
+		Planned[plugin]("Auth"),
+		Planned[plugin](""),
+		PlannedWithID[plugin]("Export", 2),
+	)
+
+	expected := map[string]string{
+		"Core":    "duplicate name in enum set",
+		"Billing": "duplicate id 1 in enum set",
+		"Search":  "duplicate id 0 in enum set",
+		"Auth":    "duplicate name in enum set",
+		"":        "enum name cannot be empty",
+	}
+
+	if len(problems) != len(expected) {
+		t.Fatalf("expected %d problems, got %v", len(expected), problems)
+	}
+
+	for _, p := range problems {
+		if !strings.Contains(p.Message, expected[p.Name]) || p.Type != getType[plugin]() {
+			t.Errorf("unexpected problem %s", p)
+		}
+
+		if p.Name != "" && !strings.Contains(p.Message, "plan_test.go") {
+			t.Errorf("expected the call site in %q", p.Message)
+		}
+	}
+
+	// Nothing is registered.
+	if enums := EnumsByType[plugin](); len(enums) != 1 {
+		t.Errorf("expected 1 enum, got %v", enums)
+	}
+
+	if problems := Plan(Planned[plugin]("Auth"), PlannedWithID[plugin]("Billing", 10)); len(problems) != 0 {
+		t.Errorf("unexpected problems %v", problems)
+	}
+
+	type unregistered int8
+
+	if problems := Plan(Planned[unregistered]("A"), Planned[unregistered]("B", HashID())); len(problems) != 0 {
+		t.Errorf("unexpected problems %v", problems)
+	}
+
+	setByTypeMu.RLock()
+	_, ok := setByType[getType[unregistered]()]
+	setByTypeMu.RUnlock()
+
+	if ok {
+		t.Errorf("expected no set to be created")
+	}
+
+	Freeze[plugin]()
+
+	if problems := Plan(Planned[plugin]("Auth")); len(problems) != 1 || !strings.Contains(problems[0].Message, "frozen") {
+		t.Errorf("expected a frozen problem, got %v", problems)
+	}
+}
+
+func TestPlan_Capacity(t *testing.T) {
+	type small uint8
+
+	registrations := make([]Registration[small], 0, 300)
+	for i := 0; i < 300; i++ {
+		registrations = append(registrations, Planned[small](strings.Repeat("x", i+1)))
+	}
+
+	problems := Plan(registrations...)
+	if len(problems) != 300-256 || !strings.Contains(problems[0].Message, "too many enums") {
+		t.Errorf("expected %d capacity problems, got %d: %v", 300-256, len(problems), problems[:1])
+	}
+}
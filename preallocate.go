@@ -0,0 +1,35 @@
+package enum
+
+import (
+	"strconv"
+	"unsafe"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Preallocate pre-sizes the internal storage for enums associated with type T
+// so at least n of them can be registered without it being resized. This
+// reduces allocations and rehashing during initialization of types with
+// thousands of enums (locales, airport codes, etc). It should be called
+// before the enums are created. n is capped to the number of distinct values
+// of type T (256 for byte).
+func Preallocate[T constraints.Integer](n int) {
+	if limit := maxEnums[T](); limit > 0 && n > limit {
+		n = limit
+	}
+
+	getOrCreateSetForType[T]().Preallocate(n)
+}
+
+// maxEnums returns the number of distinct values of type T, or 0 if it does
+// not fit in an int.
+func maxEnums[T constraints.Integer]() int {
+	var zero T
+
+	bits := int(unsafe.Sizeof(zero)) * 8
+	if bits >= strconv.IntSize-1 {
+		return 0
+	}
+
+	return 1 << bits
+}
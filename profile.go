@@ -0,0 +1,194 @@
+package enum
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Profile defines how enums are encoded for a specific target (for example,
+// a public API, a message queue or a database), as an alternative to the
+// default encoding by name used by MarshalJSON, MarshalText and Value.
+type Profile struct {
+	// Rename, if set, converts names for the target (for example,
+	// ToSnakeCase). Decoding only accepts converted names.
+	Rename func(string) string
+
+	// IDs makes enums be encoded by ID instead of by name.
+	IDs bool
+}
+
+// SetProfile configures the profile with the given name for type T. It is
+// usually called from an init function. The empty name is reserved for the
+// default encoding.
+func SetProfile[T constraints.Integer](name string, p Profile) {
+	if name == "" {
+		panic("profile name cannot be empty")
+	}
+
+	getOrCreateSetForType[T]().SetProfile(name, p)
+}
+
+type profileKey struct{}
+
+// WithProfile returns a copy of ctx that selects the profile with the given
+// name, so it can be passed down to where enums are encoded.
+func WithProfile(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, profileKey{}, name)
+}
+
+// ProfileFromContext returns the name of the profile selected with
+// WithProfile, or the empty string (the default encoding) if there is none.
+func ProfileFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(profileKey{}).(string)
+
+	return name
+}
+
+// MarshalTextProfile encodes the given value as text with the given profile.
+// The empty profile name selects the default encoding.
+func MarshalTextProfile[E EnumType[T], T constraints.Integer](profile string, value E) ([]byte, error) {
+	e := struct{ internalEnumWrapper[T] }(value).internalEnumWrapper
+
+	p, err := getProfile[T](profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if !e.Valid() {
+		return nil, fmt.Errorf("enum not initialized")
+	}
+
+	m, err := e.marshaled()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.IDs {
+		return []byte(formatID(m.id)), nil
+	}
+
+	return []byte(p.rename(m.name)), nil
+}
+
+// UnmarshalTextProfile decodes a value encoded with MarshalTextProfile with
+// the same profile.
+func UnmarshalTextProfile[T constraints.Integer](profile string, text []byte) (Enum[T], error) {
+	p, err := getProfile[T](profile)
+	if err != nil {
+		return Enum[T]{}, err
+	}
+
+	if p.IDs {
+		id, ok := parseID[T](string(text))
+		if !ok {
+			return Enum[T]{}, fmt.Errorf("invalid id %s for type %s", text, getTypeName[T]())
+		}
+
+		return FromRaw(id)
+	}
+
+	if p.Rename == nil {
+		return EnumByTypeAndName[T](string(text))
+	}
+
+	s := getOrCreateSetForType[T]()
+	s.MarkUsed()
+
+	e := s.GetRenamed(profile, p, string(text))
+	if e == nil {
+		return Enum[T]{}, fmt.Errorf("name %s could not be found in enum set for type %s", text, getTypeName[T]())
+	}
+
+	e, err = resolveDecoded(s, e)
+	if err != nil {
+		return Enum[T]{}, err
+	}
+
+	return Enum[T]{internalEnumWrapper[T]{e}}, nil
+}
+
+// MarshalJSONProfile encodes the given value as JSON with the given profile.
+// IDs are encoded as JSON numbers.
+func MarshalJSONProfile[E EnumType[T], T constraints.Integer](profile string, value E) ([]byte, error) {
+	text, err := MarshalTextProfile[E](profile, value)
+	if err != nil {
+		return nil, err
+	}
+
+	if p, _ := getProfile[T](profile); p.IDs {
+		return text, nil
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSONProfile decodes a value encoded with MarshalJSONProfile with
+// the same profile.
+func UnmarshalJSONProfile[T constraints.Integer](profile string, data []byte) (Enum[T], error) {
+	p, err := getProfile[T](profile)
+	if err != nil {
+		return Enum[T]{}, err
+	}
+
+	if p.IDs {
+		// Numbers are decoded as json.Number so unsigned IDs above the range
+		// of int64 are kept.
+		var v any
+
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+
+		if err := decoder.Decode(&v); err != nil || decoder.More() {
+			return Enum[T]{}, fmt.Errorf("source should be an id, got %s", data)
+		}
+
+		number, ok := v.(json.Number)
+		if !ok {
+			return Enum[T]{}, fmt.Errorf("source should be an id, got %s", data)
+		}
+
+		id, ok := parseID[T](number.String())
+		if !ok {
+			return Enum[T]{}, fmt.Errorf("source should be an id, got %s", data)
+		}
+
+		return FromRaw(id)
+	}
+
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return Enum[T]{}, fmt.Errorf("source should be a string, got %s", data)
+	}
+
+	return UnmarshalTextProfile[T](profile, []byte(name))
+}
+
+func getProfile[T constraints.Integer](name string) (Profile, error) {
+	if name == "" {
+		return Profile{}, nil
+	}
+
+	s, err := getSetForType[T]()
+	if err != nil {
+		return Profile{}, err
+	}
+
+	p, ok := s.Profile(name)
+	if !ok {
+		return Profile{}, fmt.Errorf("no profile %s for type %s", name, getTypeName[T]())
+	}
+
+	return p, nil
+}
+
+func (p Profile) rename(name string) string {
+	if p.Rename == nil {
+		return name
+	}
+
+	return p.Rename(name)
+}
@@ -0,0 +1,115 @@
+package enum
+
+import (
+	"context"
+	"testing"
+)
+
+func TestProfile(t *testing.T) {
+	type status int
+
+	New[status]("Unknown")
+	inProgress := New[status]("InProgress")
+
+	SetProfile[status]("public-api", Profile{Rename: ToSnakeCase})
+	SetProfile[status]("db", Profile{IDs: true})
+
+	tests := []struct {
+		profile      string
+		expectedText string
+		expectedJSON string
+	}{
+		{"", "InProgress", `"InProgress"`},
+		{"public-api", "in_progress", `"in_progress"`},
+		{"db", "1", `1`},
+	}
+
+	for _, test := range tests {
+		text, err := MarshalTextProfile(test.profile, inProgress)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if string(text) != test.expectedText {
+			t.Errorf("expected %s for profile %q, got %s", test.expectedText, test.profile, text)
+		}
+
+		data, err := MarshalJSONProfile(test.profile, inProgress)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if string(data) != test.expectedJSON {
+			t.Errorf("expected %s for profile %q, got %s", test.expectedJSON, test.profile, data)
+		}
+
+		e, err := UnmarshalTextProfile[status](test.profile, text)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if e != inProgress {
+			t.Errorf("expected %s, got %s", inProgress, e)
+		}
+
+		e, err = UnmarshalJSONProfile[status](test.profile, data)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if e != inProgress {
+			t.Errorf("expected %s, got %s", inProgress, e)
+		}
+	}
+
+	for _, test := range []struct {
+		profile string
+		data    string
+	}{
+		{"public-api", `"InProgress"`},
+		{"public-api", `1`},
+		{"db", `"1"`},
+		{"db", `7`},
+		{"missing", `"InProgress"`},
+	} {
+		if _, err := UnmarshalJSONProfile[status](test.profile, []byte(test.data)); err == nil {
+			t.Errorf("expected error for %s with profile %q, got nil", test.data, test.profile)
+		}
+	}
+
+	if _, err := MarshalTextProfile("missing", inProgress); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestProfile_UnsignedIDs(t *testing.T) {
+	type checksum uint64
+
+	high := NewWithID[checksum]("High", 1<<63+5)
+
+	SetProfile[checksum]("db", Profile{IDs: true})
+
+	data, err := MarshalJSONProfile("db", high)
+	if err != nil || string(data) != "9223372036854775813" {
+		t.Fatalf("expected 9223372036854775813, got %s (%v)", data, err)
+	}
+
+	if e, err := UnmarshalJSONProfile[checksum]("db", data); err != nil || e != high {
+		t.Errorf("expected %s, got %v (%v)", high, e, err)
+	}
+
+	if _, err := UnmarshalJSONProfile[checksum]("db", []byte("-9223372036854775803")); err == nil {
+		t.Errorf("expected error for a negative ID, got nil")
+	}
+}
+
+func TestProfile_Context(t *testing.T) {
+	ctx := context.Background()
+	if name := ProfileFromContext(ctx); name != "" {
+		t.Errorf("expected default profile, got %q", name)
+	}
+
+	if name := ProfileFromContext(WithProfile(ctx, "kafka")); name != "kafka" {
+		t.Errorf("expected kafka, got %q", name)
+	}
+}
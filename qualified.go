@@ -0,0 +1,33 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// QualifiedName returns the name of this Enum qualified by the name of the
+// associated type T (for example, "Role.Admin"). This is useful where enums of
+// different types are mixed, as names (like "Unknown") are usually not unique
+// across types. The package of type T is not included.
+func (e internalEnumWrapper[T]) QualifiedName() string {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return getType[T]().Name() + "." + e.internalEnum.name
+}
+
+// ParseQualified returns the Enum associated with type T for the given
+// qualified name (as returned by QualifiedName). This returns a non-nil error
+// if the name is not qualified by the name of type T or if no Enum with the
+// given name exists.
+func ParseQualified[T constraints.Integer](qualified string) (Enum[T], error) {
+	typeName, name, ok := strings.Cut(qualified, ".")
+	if !ok || typeName != getType[T]().Name() {
+		return Enum[T]{}, fmt.Errorf("name %s is not qualified by type %s", qualified, getType[T]().Name())
+	}
+
+	return EnumByTypeAndName[T](name)
+}
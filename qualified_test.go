@@ -0,0 +1,39 @@
+package enum
+
+import "testing"
+
+func TestQualifiedName(t *testing.T) {
+	if name := Admin.QualifiedName(); name != "Role.Admin" {
+		t.Errorf("expected Role.Admin, got %s", name)
+	}
+
+	e, err := ParseQualified[Role]("Role.Admin")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if RoleEnum(e) != Admin {
+		t.Errorf("expected %s, got %s", Admin, e)
+	}
+
+	for _, qualified := range []string{"Admin", "Permission.Read", "Role.Missing", ".Admin"} {
+		if _, err := ParseQualified[Role](qualified); err == nil {
+			t.Errorf("expected error for %s, got nil", qualified)
+		}
+	}
+}
+
+func TestQualifiedName_Dots(t *testing.T) {
+	type dotted int
+
+	e := New[dotted]("a.b")
+
+	got, err := ParseQualified[dotted](e.QualifiedName())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != e {
+		t.Errorf("expected %s, got %s", e, got)
+	}
+}
@@ -0,0 +1,120 @@
+package enum
+
+import (
+	"container/heap"
+
+	"golang.org/x/exp/constraints"
+)
+
+// QueueOrder defines which enums are popped first from a PriorityQueue.
+type QueueOrder int
+
+const (
+	// LowestIDFirst pops items with enums with lower IDs first (for example,
+	// when P0 is declared before P1).
+	LowestIDFirst QueueOrder = iota
+
+	// HighestIDFirst pops items with enums with higher IDs first (for example,
+	// when Critical is declared after Warning).
+	HighestIDFirst
+)
+
+// PriorityQueue orders items of type V by an associated enum of type T, based
+// on the enum IDs. Items with the same enum are popped in the order they were
+// pushed. It is not safe for concurrent use.
+type PriorityQueue[T constraints.Integer, V any] struct {
+	h queueHeap[T, V]
+}
+
+// NewPriorityQueue returns a new empty PriorityQueue with the given order.
+func NewPriorityQueue[T constraints.Integer, V any](order QueueOrder) *PriorityQueue[T, V] {
+	return &PriorityQueue[T, V]{h: queueHeap[T, V]{highestFirst: order == HighestIDFirst}}
+}
+
+// Push adds the given item with the given priority. This panics if the
+// priority is not a valid enum.
+func (q *PriorityQueue[T, V]) Push(priority Member[T], item V) {
+	e := Enum[T]{priority.wrapper()}
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	heap.Push(&q.h, queueItem[T, V]{priority: e, seq: q.h.seq, item: item})
+	q.h.seq++
+}
+
+// Pop removes and returns the next item and its priority. It returns false if
+// the queue is empty.
+func (q *PriorityQueue[T, V]) Pop() (V, Enum[T], bool) {
+	if len(q.h.items) == 0 {
+		var zero V
+		return zero, Enum[T]{}, false
+	}
+
+	i := heap.Pop(&q.h).(queueItem[T, V])
+
+	return i.item, i.priority, true
+}
+
+// Peek is like Pop but does not remove the item.
+func (q *PriorityQueue[T, V]) Peek() (V, Enum[T], bool) {
+	if len(q.h.items) == 0 {
+		var zero V
+		return zero, Enum[T]{}, false
+	}
+
+	i := q.h.items[0]
+
+	return i.item, i.priority, true
+}
+
+// Len returns the number of items in the queue.
+func (q *PriorityQueue[T, V]) Len() int {
+	return len(q.h.items)
+}
+
+type queueItem[T constraints.Integer, V any] struct {
+	priority Enum[T]
+	seq      uint64 // Insertion order for items with the same priority.
+	item     V
+}
+
+// queueHeap implements heap.Interface.
+type queueHeap[T constraints.Integer, V any] struct {
+	items        []queueItem[T, V]
+	seq          uint64
+	highestFirst bool
+}
+
+func (h *queueHeap[T, V]) Len() int {
+	return len(h.items)
+}
+
+func (h *queueHeap[T, V]) Less(i, j int) bool {
+	a, b := h.items[i], h.items[j]
+
+	if ida, idb := a.priority.internalEnum.id, b.priority.internalEnum.id; ida != idb {
+		return (ida < idb) != h.highestFirst
+	}
+
+	return a.seq < b.seq
+}
+
+func (h *queueHeap[T, V]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *queueHeap[T, V]) Push(x any) {
+	h.items = append(h.items, x.(queueItem[T, V]))
+}
+
+func (h *queueHeap[T, V]) Pop() any {
+	n := len(h.items) - 1
+	i := h.items[n]
+
+	// Do not keep a reference to the popped item.
+	h.items[n] = queueItem[T, V]{}
+	h.items = h.items[:n]
+
+	return i
+}
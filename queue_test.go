@@ -0,0 +1,64 @@
+package enum
+
+import "testing"
+
+func TestPriorityQueue(t *testing.T) {
+	type severity int
+
+	info := New[severity]("Info")
+	warning := New[severity]("Warning")
+	critical := New[severity]("Critical")
+
+	push := func(q *PriorityQueue[severity, string]) {
+		q.Push(warning, "w1")
+		q.Push(info, "i1")
+		q.Push(critical, "c1")
+		q.Push(warning, "w2")
+		q.Push(critical, "c2")
+		q.Push(info, "i2")
+	}
+
+	tests := []struct {
+		order    QueueOrder
+		expected []string
+	}{
+		{HighestIDFirst, []string{"c1", "c2", "w1", "w2", "i1", "i2"}},
+		{LowestIDFirst, []string{"i1", "i2", "w1", "w2", "c1", "c2"}},
+	}
+
+	for _, test := range tests {
+		q := NewPriorityQueue[severity, string](test.order)
+		push(q)
+
+		if q.Len() != len(test.expected) {
+			t.Fatalf("expected %d items, got %d", len(test.expected), q.Len())
+		}
+
+		if item, _, _ := q.Peek(); item != test.expected[0] {
+			t.Errorf("expected %s, got %s", test.expected[0], item)
+		}
+
+		for _, expected := range test.expected {
+			item, priority, ok := q.Pop()
+			if !ok || item != expected {
+				t.Errorf("expected %s, got %s (%v)", expected, item, ok)
+			}
+
+			if priority.Name()[0] != item[0]-'a'+'A' {
+				t.Errorf("unexpected priority %s for %s", priority, item)
+			}
+		}
+
+		if _, _, ok := q.Pop(); ok {
+			t.Errorf("expected empty queue")
+		}
+
+		if _, _, ok := q.Peek(); ok {
+			t.Errorf("expected empty queue")
+		}
+	}
+
+	expectPanic(t, func() {
+		NewPriorityQueue[severity, string](LowestIDFirst).Push(Enum[severity]{}, "invalid")
+	})
+}
@@ -0,0 +1,58 @@
+package enum
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// FromRaw returns the Enum associated with type T whose ID is the given raw
+// value. It is the counterpart of Raw for code that still uses plain values of
+// type T (for example, legacy constants) alongside enums. Retired values are
+// resolved to their replacements. This returns a non-nil error if raw is not
+// the ID of a registered Enum.
+func FromRaw[T constraints.Integer](raw T) (Enum[T], error) {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return Enum[T]{}, err
+	}
+
+	e, err := getInternalEnumForID(raw)
+	if err != nil {
+		return Enum[T]{}, err
+	}
+
+	if e.unrecognized {
+		// IDs of unrecognized enums are assigned dynamically so they can not
+		// correspond to raw values in code.
+		return Enum[T]{}, fmt.Errorf("id %d could not be found in enum set for type %s", raw, getTypeName[T]())
+	}
+
+	return Enum[T]{internalEnumWrapper[T]{s.Resolve(e)}}, nil
+}
+
+// FromID returns the Enum associated with type T with the given ID. IDs can be
+// negative (for example, a -1 sentinel for unset values mandated by an
+// external system, registered with NewWithID). Retired values are resolved to
+// their replacements. Unlike FromRaw, unrecognized enums (see Open) are
+// returned as their IDs can be the result of an earlier lookup by name in the
+// same process. This returns a non-nil error if no Enum has the given ID.
+func FromID[T constraints.Integer](id T) (Enum[T], error) {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return Enum[T]{}, err
+	}
+
+	e, err := getInternalEnumForID(id)
+	if err != nil {
+		return Enum[T]{}, err
+	}
+
+	return Enum[T]{internalEnumWrapper[T]{s.Resolve(e)}}, nil
+}
+
+// Raw returns the value of type T associated with this Enum (its ID). It is
+// the counterpart of FromRaw.
+func (e internalEnumWrapper[T]) Raw() T {
+	return e.ID()
+}
@@ -0,0 +1,86 @@
+package enum
+
+import "testing"
+
+func TestFromRaw(t *testing.T) {
+	type legacy int
+
+	const (
+		legacyFirst legacy = iota
+		legacySecond
+		legacyThird
+	)
+
+	first := New[legacy]("First")
+	second := New[legacy]("Second")
+	old := New[legacy]("Old")
+	Retire(old, second)
+
+	for raw, expected := range map[legacy]Enum[legacy]{legacyFirst: first, legacySecond: second, legacyThird: second} {
+		e, err := FromRaw(raw)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if e != expected {
+			t.Errorf("expected %s for %d, got %s", expected, raw, e)
+		}
+	}
+
+	if first.Raw() != legacyFirst {
+		t.Errorf("expected %d, got %d", legacyFirst, first.Raw())
+	}
+
+	if _, err := FromRaw(legacy(10)); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	Open[legacy]()
+
+	if _, err := EnumByTypeAndName[legacy]("New"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := FromRaw(legacy(3)); err == nil {
+		t.Errorf("expected error for unrecognized value, got nil")
+	}
+
+	type unregistered int
+
+	if _, err := FromRaw(unregistered(0)); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestFromID(t *testing.T) {
+	type priority int8
+
+	unset := NewWithID[priority]("Unset", -1)
+	low := New[priority]("Low")
+
+	for id, expected := range map[priority]Enum[priority]{-1: unset, 0: low} {
+		e, err := FromID(id)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if e != expected {
+			t.Errorf("expected %s for %d, got %s", expected, id, e)
+		}
+	}
+
+	if _, err := FromID(priority(-2)); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	Open[priority]()
+
+	unrecognized, err := EnumByTypeAndName[priority]("New")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if e, err := FromID(unrecognized.ID()); err != nil || e != unrecognized {
+		t.Errorf("expected %s, got %s (%v)", unrecognized, e, err)
+	}
+}
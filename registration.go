@@ -0,0 +1,39 @@
+package enum
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// callSite returns the file:line of the caller of the function calling
+// callSite, skipping skip additional frames. It returns an empty string if
+// the information is not available.
+func callSite(skip int) string {
+	_, file, line, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+// RegisteredAt returns the file:line of the call that registered this Enum
+// (usually a call to New). It returns an empty string for unrecognized enums
+// or if the information is not available.
+func (e internalEnumWrapper[T]) RegisteredAt() string {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return e.internalEnum.registeredAt
+}
+
+// registeredAtSuffix returns a suffix for error messages referencing this
+// enum that includes where it was registered, if known.
+func (e *internalEnum[T]) registeredAtSuffix() string {
+	if e.registeredAt == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(" (%s already registered at %s)", e.name, e.registeredAt)
+}
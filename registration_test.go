@@ -0,0 +1,41 @@
+package enum
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestRegisteredAt(t *testing.T) {
+	type registered int
+
+	_, file, line, _ := runtime.Caller(0)
+	first := New[registered]("First")
+
+	expected := fmt.Sprintf("%s:%d", file, line+1)
+	if first.RegisteredAt() != expected {
+		t.Errorf("expected %s, got %s", expected, first.RegisteredAt())
+	}
+
+	Open[registered]()
+
+	unrecognized, err := EnumByTypeAndName[registered]("Other")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if unrecognized.RegisteredAt() != "" {
+		t.Errorf("expected empty call site, got %s", unrecognized.RegisteredAt())
+	}
+
+	defer func() {
+		msg, _ := recover().(string)
+		if !strings.Contains(msg, filepath.Base(file)) {
+			t.Errorf("expected panic message to contain the original call site, got %q", msg)
+		}
+	}()
+
+	New[registered]("First")
+}
@@ -0,0 +1,42 @@
+package enum
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Remap maps IDs used by a legacy system (keys) to the IDs of the enums
+// associated with type T (values), for one-off data migrations. The mapping is
+// only used by ParseLegacyID so it never affects FromID, FromRaw or decoding.
+// Calling Remap again adds to the existing mapping. This panics if a legacy ID
+// is already mapped to a different ID.
+func Remap[T constraints.Integer](m map[T]T) {
+	if err := getOrCreateSetForType[T]().Remap(m); err != nil {
+		panic(err.Error())
+	}
+}
+
+// ParseLegacyID returns the Enum associated with type T that the given legacy
+// ID is mapped to with Remap. Retired values are resolved to their
+// replacements. This returns a non-nil error if the legacy ID is not mapped
+// (legacy IDs are never used as is, as they may collide with current IDs) or
+// if it is mapped to an ID that is not associated with any Enum.
+func ParseLegacyID[T constraints.Integer](legacy T) (Enum[T], error) {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return Enum[T]{}, err
+	}
+
+	id, ok := s.LegacyID(legacy)
+	if !ok {
+		return Enum[T]{}, fmt.Errorf("legacy id %d of type %s is not mapped (see Remap)", legacy, getTypeName[T]())
+	}
+
+	e, err := FromID(id)
+	if err != nil {
+		return Enum[T]{}, fmt.Errorf("legacy id %d of type %s: %w", legacy, getTypeName[T](), err)
+	}
+
+	return e, nil
+}
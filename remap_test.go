@@ -0,0 +1,44 @@
+package enum
+
+import "testing"
+
+func TestParseLegacyID(t *testing.T) {
+	type legacyStatus int
+
+	active := New[legacyStatus]("Active")
+	inactive := New[legacyStatus]("Inactive")
+	old := New[legacyStatus]("Old")
+	Retire(old, inactive)
+
+	Remap(map[legacyStatus]legacyStatus{100: active.ID(), 200: inactive.ID()})
+	Remap(map[legacyStatus]legacyStatus{100: active.ID(), 300: old.ID(), 400: 10})
+
+	for legacy, expected := range map[legacyStatus]Enum[legacyStatus]{100: active, 200: inactive, 300: inactive} {
+		e, err := ParseLegacyID(legacy)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if e != expected {
+			t.Errorf("expected %s for %d, got %s", expected, legacy, e)
+		}
+	}
+
+	for _, legacy := range []legacyStatus{0, 400} {
+		if _, err := ParseLegacyID(legacy); err == nil {
+			t.Errorf("expected error for %d, got nil", legacy)
+		}
+	}
+
+	if e, err := FromID[legacyStatus](0); err != nil || e != active {
+		t.Errorf("expected FromID to not be affected, got %v (%v)", e, err)
+	}
+
+	if _, err := FromID[legacyStatus](100); err == nil {
+		t.Errorf("expected FromID to not be affected, got nil error")
+	}
+
+	expectPanic(t, func() {
+		Remap(map[legacyStatus]legacyStatus{100: inactive.ID()})
+	})
+}
@@ -0,0 +1,116 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Problem describes a suspicious pattern found in the registered enums of a
+// type by Validate.
+type Problem struct {
+	// Type is the type T associated with the enums.
+	Type reflect.Type
+
+	// Name is the name of the enum the problem refers to, if any.
+	Name string
+
+	Message string
+}
+
+// String implements the fmt.Stringer interface.
+func (p Problem) String() string {
+	if p.Name == "" {
+		return fmt.Sprintf("%s: %s", p.Type, p.Message)
+	}
+
+	return fmt.Sprintf("%s.%s: %s", p.Type, p.Name, p.Message)
+}
+
+// problemReporter is implemented by all sets.
+type problemReporter interface {
+	problems(t reflect.Type) []Problem
+}
+
+// Validate checks the enums of all types for suspicious patterns and returns
+// the problems found, sorted by type and name. It is intended to be run at
+// startup (at the start of main or in a test) once all enums are registered.
+// The following are reported:
+//
+//   - Names that only differ in case, which are ambiguous when decoding case
+//     insensitively.
+//   - Types without an enum with ID 0, so the zero value of T (for example,
+//     in a zeroed database column) does not map to a value.
+//   - Deprecated enums that were neither retired nor superseded.
+//   - Types registered by different major versions of the same module (for
+//     example, example.com/roles.Role and example.com/roles/v2.Role, in a
+//     diamond dependency), naming both modules and the names and IDs that
+//     conflict.
+func Validate() []Problem {
+	setByTypeMu.RLock()
+	sets := make(map[reflect.Type]any, len(setByType))
+	for t, s := range setByType {
+		sets[t] = s
+	}
+	setByTypeMu.RUnlock()
+
+	var problems []Problem
+	for t, s := range sets {
+		problems = append(problems, s.(problemReporter).problems(t)...)
+	}
+
+	problems = append(problems, versionProblems(sets)...)
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		if ti, tj := problems[i].Type.String(), problems[j].Type.String(); ti != tj {
+			return ti < tj
+		}
+
+		return problems[i].Name < problems[j].Name
+	})
+
+	return problems
+}
+
+func (s *internalSet[T]) problems(t reflect.Type) []Problem {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.enums) == 0 {
+		return nil
+	}
+
+	var problems []Problem
+
+	if e, ok := s.idEnumMap[0]; !ok || e.unrecognized {
+		problems = append(problems, Problem{
+			Type:    t,
+			Message: "no enum with ID 0 (the zero value does not map to a value)",
+		})
+	}
+
+	folded := make(map[string]*internalEnum[T], len(s.enums))
+	for _, e := range s.enums {
+		key := strings.ToLower(e.name)
+		if other, ok := folded[key]; ok {
+			problems = append(problems, Problem{
+				Type:    t,
+				Name:    e.name,
+				Message: fmt.Sprintf("name only differs in case from %s", other.name),
+			})
+		} else {
+			folded[key] = e
+		}
+
+		if e.deprecated && s.next(e) == nil {
+			problems = append(problems, Problem{
+				Type:    t,
+				Name:    e.name,
+				Message: "deprecated without a replacement (see Retire and Supersede)",
+			})
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,51 @@
+package enum
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	type suspicious int
+	type fine int
+
+	New[suspicious]("None", Deprecated())
+	New[suspicious]("Admin")
+	New[suspicious]("admin")
+	old := New[suspicious]("Old", Deprecated())
+	Retire(old, New[suspicious]("New"))
+
+	New[fine]("Unknown")
+	New[fine]("Value")
+
+	problemsByType := make(map[reflect.Type][]Problem)
+	for _, p := range Validate() {
+		problemsByType[p.Type] = append(problemsByType[p.Type], p)
+	}
+
+	if problems := problemsByType[reflect.TypeOf(fine(0))]; len(problems) != 0 {
+		t.Errorf("expected no problems, got %v", problems)
+	}
+
+	problems := problemsByType[reflect.TypeOf(suspicious(0))]
+	if len(problems) != 2 {
+		t.Fatalf("expected 2 problems, got %v", problems)
+	}
+
+	if problems[0].Name != "None" || problems[1].Name != "admin" {
+		t.Errorf("unexpected problems: %v", problems)
+	}
+
+	problems = problemsByType[reflect.TypeOf(nonZeroValue(0))]
+	if len(problems) != 1 || problems[0].Name != "" {
+		t.Errorf("expected missing zero value problem, got %v", problems)
+	}
+}
+
+type nonZeroValue int
+
+var _ = Wrap(nonZeroValue(1))
+
+func (v nonZeroValue) String() string {
+	return "One"
+}
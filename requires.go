@@ -0,0 +1,90 @@
+package enum
+
+import "golang.org/x/exp/constraints"
+
+// Requires makes using the Enum require all the given permissions, which are
+// enums of another type. This allows answering questions like "which statuses
+// can this user set" from the enum definitions:
+//
+//	var (
+//		Draft     = StatusEnum(enum.New[Status]("Draft"))
+//		Published = StatusEnum(enum.New[Status]("Published", enum.Requires(PublishPermission)))
+//	)
+//
+//	if !enum.CanUse(user.Permissions, status) {
+//		return errForbidden
+//	}
+//
+// Requires can be given multiple times, including with permissions of
+// different types.
+func Requires[E EnumType[P], P constraints.Integer](permissions ...E) Option {
+	return func(a *attributes) {
+		for _, p := range permissions {
+			e := Enum[P](p)
+			if !e.Valid() {
+				panic(notInitialized[P]())
+			}
+
+			a.requires = append(a.requires, e)
+		}
+	}
+}
+
+// Required returns the permissions of type P needed to use the given value
+// (see Requires), in the order they were given:
+//
+//	perms := enum.Required[Permission](Published)
+func Required[P constraints.Integer, E EnumType[T], T constraints.Integer](value E) []Enum[P] {
+	e := Enum[T](value)
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	var permissions []Enum[P]
+	for _, r := range e.internalEnum.requires {
+		if p, ok := r.(Enum[P]); ok {
+			permissions = append(permissions, p)
+		}
+	}
+
+	return permissions
+}
+
+// CanUse returns true if the given permissions include all the permissions
+// needed to use the given value (see Requires). Values that require
+// permissions of a type other than P can not be used.
+func CanUse[PE EnumType[P], P constraints.Integer, E EnumType[T], T constraints.Integer](permissions []PE, value E) bool {
+	e := Enum[T](value)
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	for _, r := range e.internalEnum.requires {
+		p, ok := r.(Enum[P])
+		if !ok || !containsEnum(permissions, p) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Usable returns the enums associated with type T that can be used with the
+// given permissions (see CanUse), in the order of EnumsByType:
+//
+//	allowed := enum.Usable[Status](user.Permissions)
+func Usable[T constraints.Integer, PE EnumType[P], P constraints.Integer](permissions []PE) []Enum[T] {
+	return Where(func(e Enum[T]) bool {
+		return CanUse(permissions, e)
+	})
+}
+
+func containsEnum[E EnumType[T], T constraints.Integer](values []E, value Enum[T]) bool {
+	for _, v := range values {
+		if Enum[T](v) == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,46 @@
+package enum
+
+import "testing"
+
+func TestRequires(t *testing.T) {
+	type status int
+
+	draft := New[status]("Draft")
+	published := New[status]("Published", Requires(Write))
+	archived := New[status]("Archived", Requires(Read, Write), Requires(Admin))
+
+	if perms := Required[Permission](archived); len(perms) != 2 || perms[0] != Enum[Permission](Read) || perms[1] != Enum[Permission](Write) {
+		t.Errorf("unexpected permissions %v", perms)
+	}
+
+	if perms := Required[Role](archived); len(perms) != 1 || perms[0] != Enum[Role](Admin) {
+		t.Errorf("unexpected roles %v", perms)
+	}
+
+	if perms := Required[Permission](draft); len(perms) != 0 {
+		t.Errorf("unexpected permissions %v", perms)
+	}
+
+	user := []PermissionEnum{Read}
+	editor := []PermissionEnum{Read, Write}
+
+	if !CanUse(user, draft) {
+		t.Errorf("expected %s to be usable without permissions", draft)
+	}
+
+	if CanUse(user, published) {
+		t.Errorf("expected %s to not be usable", published)
+	}
+
+	if !CanUse(editor, published) {
+		t.Errorf("expected %s to be usable", published)
+	}
+
+	if CanUse(editor, archived) {
+		t.Errorf("expected %s to not be usable without the admin role", archived)
+	}
+
+	if usable := Usable[status](editor); len(usable) != 2 || usable[0] != draft || usable[1] != published {
+		t.Errorf("unexpected usable enums %v", usable)
+	}
+}
@@ -0,0 +1,78 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// enumResetter is implemented by pointers to Enum[T] and to every type
+// derived from it.
+type enumResetter interface {
+	reset()
+}
+
+// Reset sets this Enum to the default of its type: the value set with
+// SetZeroValue, if any, or the zero value of Enum[T] (which is not
+// initialized) otherwise.
+func (e *internalEnumWrapper[T]) Reset() {
+	e.reset()
+}
+
+func (e *internalEnumWrapper[T]) reset() {
+	e.internalEnum = nil
+
+	if s, err := getSetForType[T](); err == nil {
+		e.internalEnum = s.Zero()
+	}
+}
+
+// ResetStruct calls Reset for all Enum-typed fields in the struct pointed to
+// by ptr, including the fields of nested (and embedded) structs and the
+// elements of arrays. Unexported fields are ignored, as are pointers, slices
+// and maps (which may be shared with other values). It panics if ptr is not a
+// non-nil pointer to a struct.
+//
+// It is intended for structs reused through a sync.Pool, whose Enum fields
+// would otherwise keep the values set by their previous user:
+//
+//	func (r *Request) Reset() {
+//		*r = Request{Items: r.Items[:0]}
+//		enum.ResetStruct(r)
+//	}
+//
+// Unlike assigning the zero value of the struct, this also restores the
+// defaults set with SetZeroValue.
+func ResetStruct(ptr any) {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("ResetStruct requires a non-nil pointer to a struct, got %T", ptr))
+	}
+
+	resetValue(v.Elem())
+}
+
+func resetValue(v reflect.Value) {
+	if isEnumType(v.Type()) {
+		if v.CanSet() {
+			v.Addr().Interface().(enumResetter).reset()
+		}
+
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			// Exported fields of embedded structs are reachable even if
+			// the embedded type is not exported.
+			if f := t.Field(i); f.IsExported() || f.Anonymous {
+				resetValue(v.Field(i))
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			resetValue(v.Index(i))
+		}
+	}
+}
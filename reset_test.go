@@ -0,0 +1,107 @@
+package enum
+
+import (
+	"testing"
+)
+
+func TestReset(t *testing.T) {
+	type level int
+
+	unset := New[level]("Unset")
+	high := New[level]("High")
+
+	e := high
+	e.Reset()
+
+	if e.Valid() {
+		t.Errorf("expected an Enum that is not initialized, got %s", e)
+	}
+
+	SetZeroValue(unset)
+
+	e = high
+	e.Reset()
+
+	if e != unset {
+		t.Errorf("expected %s, got %v", unset, e)
+	}
+
+	r := Admin
+	r.Reset()
+
+	if r.Valid() {
+		t.Errorf("expected an Enum that is not initialized, got %s", r)
+	}
+}
+
+func TestResetStruct(t *testing.T) {
+	type tier int
+
+	free := New[tier]("Free")
+	paid := New[tier]("Paid")
+	SetZeroValue(free)
+
+	type nested struct {
+		Tier Enum[tier]
+	}
+
+	type embedded struct {
+		Fallback Enum[tier]
+	}
+
+	type request struct {
+		embedded
+		Role    RoleEnum
+		Tier    Enum[tier]
+		Tiers   [2]Enum[tier]
+		Nested  nested
+		Pointer *nested
+		Slice   []Enum[tier]
+		Name    string
+		hidden  Enum[tier]
+	}
+
+	shared := &nested{Tier: paid}
+
+	r := &request{
+		embedded: embedded{Fallback: paid},
+		Role:     Admin,
+		Tier:     paid,
+		Tiers:    [2]Enum[tier]{paid, paid},
+		Nested:   nested{Tier: paid},
+		Pointer:  shared,
+		Slice:    []Enum[tier]{paid},
+		Name:     "request",
+		hidden:   paid,
+	}
+
+	ResetStruct(r)
+
+	if r.Role.Valid() {
+		t.Errorf("expected a Role that is not initialized, got %s", r.Role)
+	}
+
+	for name, e := range map[string]Enum[tier]{
+		"Fallback": r.Fallback,
+		"Tier":     r.Tier,
+		"Tiers[0]": r.Tiers[0],
+		"Tiers[1]": r.Tiers[1],
+		"Nested":   r.Nested.Tier,
+	} {
+		if e != free {
+			t.Errorf("expected %s for %s, got %v", free, name, e)
+		}
+	}
+
+	if shared.Tier != paid || r.Slice[0] != paid || r.Name != "request" || r.hidden != paid {
+		t.Errorf("unexpected changes to %+v", r)
+	}
+
+	expectPanic(t, func() {
+		ResetStruct(request{})
+	})
+
+	expectPanic(t, func() {
+		ResetStruct((*request)(nil))
+	})
+}
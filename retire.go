@@ -0,0 +1,40 @@
+package enum
+
+import "golang.org/x/exp/constraints"
+
+// Retire removes the given value from active use while keeping it registered
+// as a tombstone, so its name and ID can never be reused. Retired values are
+// not returned by EnumsByType and decoding their names or IDs returns the
+// given replacement instead (following further replacements, if the
+// replacement itself is retired later).
+//
+// Retire is intended to be called from an init function so all values are
+// already registered. The retired value can be kept in an unexported variable
+// as it should not be used anymore:
+//
+//	var (
+//		Admin     = RoleEnum(enum.New[Role]("Admin"))
+//		moderator = RoleEnum(enum.New[Role]("Moderator"))
+//		User      = RoleEnum(enum.New[Role]("User"))
+//	)
+//
+//	func init() {
+//		enum.Retire(moderator, User)
+//	}
+//
+// This panics if any of the values is invalid, if the value was already
+// retired or if retiring it would create a replacement cycle.
+func Retire[E EnumType[T], T constraints.Integer](value, replacement E) {
+	e := Enum[T](value)
+	r := Enum[T](replacement)
+
+	if !e.Valid() || !r.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if err := getOrCreateSetForType[T]().Retire(e.internalEnum, r.internalEnum); err != nil {
+		panic(err.Error())
+	}
+
+	emit(EventDeprecated, e.internalEnum)
+}
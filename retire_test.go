@@ -0,0 +1,90 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type retireStatus int
+
+var (
+	retireActive    = New[retireStatus]("Active")
+	retireSuspended = New[retireStatus]("Suspended")
+	retireLocked    = New[retireStatus]("Locked")
+	retireDisabled  = New[retireStatus]("Disabled")
+)
+
+func init() {
+	Retire(retireSuspended, retireLocked)
+	Retire(retireLocked, retireDisabled)
+}
+
+func TestRetire(t *testing.T) {
+	if !retireSuspended.Retired() || !retireLocked.Retired() {
+		t.Errorf("expected %s and %s to be retired", retireSuspended, retireLocked)
+	}
+	if retireActive.Retired() {
+		t.Errorf("expected %s to not be retired", retireActive)
+	}
+
+	// The ID sequence is not affected.
+	if retireDisabled.ID() != 3 {
+		t.Errorf("expected ID 3, got %d", retireDisabled.ID())
+	}
+
+	enums := EnumsByType[retireStatus]()
+	if len(enums) != 2 || enums[0] != retireActive || enums[1] != retireDisabled {
+		t.Errorf("expected [Active Disabled], got %v", enums)
+	}
+
+	var e Enum[retireStatus]
+	if err := json.Unmarshal([]byte(`"Suspended"`), &e); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if e != retireDisabled {
+		t.Errorf("expected %s, got %s", retireDisabled, e)
+	}
+
+	if err := e.decodeID(int64(retireLocked.ID())); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if e != retireDisabled {
+		t.Errorf("expected %s, got %s", retireDisabled, e)
+	}
+}
+
+func TestRetire_Panics(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       Enum[retireStatus]
+		replacement Enum[retireStatus]
+	}{
+		{"invalid", Enum[retireStatus]{}, retireActive},
+		{"already retired", retireSuspended, retireActive},
+		{"self", retireActive, retireActive},
+		{"cycle", retireDisabled, retireSuspended},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected panic, got normal execution")
+				}
+			}()
+
+			Retire(test.value, test.replacement)
+		})
+	}
+}
+
+func TestRetire_New(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic, got normal execution")
+		}
+	}()
+
+	// Names of retired enums can not be reused.
+	New[retireStatus]("Suspended")
+}
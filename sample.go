@@ -0,0 +1,105 @@
+package enum
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Sampler picks enums associated with type T according to a fixed
+// distribution. It is safe for concurrent use as long as the *rand.Rand
+// passed to Sample is.
+type Sampler[T constraints.Integer] struct {
+	enums      []Enum[T]
+	cumulative []float64
+}
+
+// NewSampler returns a new Sampler that picks enums with probability
+// proportional to their weights. Weights must not be negative and at least one
+// of them must be positive.
+func NewSampler[T constraints.Integer](weights map[Enum[T]]float64) (*Sampler[T], error) {
+	enums := make([]Enum[T], 0, len(weights))
+	for e, w := range weights {
+		if !e.Valid() {
+			return nil, fmt.Errorf("enum not initialized")
+		}
+
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("invalid weight %v for enum %s", w, e)
+		}
+
+		if w > 0 {
+			enums = append(enums, e)
+		}
+	}
+
+	if len(enums) == 0 {
+		return nil, fmt.Errorf("at least one weight must be positive")
+	}
+
+	// Map iteration order is random so sort to make sampling reproducible
+	// with seeded sources.
+	sort.Slice(enums, func(i, j int) bool {
+		return enums[i].ID() < enums[j].ID()
+	})
+
+	cumulative := make([]float64, len(enums))
+
+	var total float64
+	for i, e := range enums {
+		total += weights[e]
+		cumulative[i] = total
+	}
+
+	return &Sampler[T]{enums, cumulative}, nil
+}
+
+// Sample returns a random enum using r as the source of randomness. If r is
+// nil, the default source of the math/rand package is used.
+func (s *Sampler[T]) Sample(r *rand.Rand) Enum[T] {
+	var f float64
+	if r != nil {
+		f = r.Float64()
+	} else {
+		f = rand.Float64()
+	}
+
+	target := f * s.cumulative[len(s.cumulative)-1]
+
+	// Find the first cumulative weight strictly greater than target.
+	i := sort.Search(len(s.cumulative), func(i int) bool {
+		return s.cumulative[i] > target
+	})
+	if i == len(s.enums) {
+		i--
+	}
+
+	return s.enums[i]
+}
+
+// Sample returns a random enum picked with probability proportional to the
+// given weights. See NewSampler for the requirements on weights. If sampling
+// repeatedly from the same distribution, using a Sampler is more efficient.
+func Sample[T constraints.Integer](weights map[Enum[T]]float64) (Enum[T], error) {
+	s, err := NewSampler(weights)
+	if err != nil {
+		return Enum[T]{}, err
+	}
+
+	return s.Sample(nil), nil
+}
+
+// RandomValue returns one of the enums associated with type T picked with
+// uniform probability using the default source of the math/rand package. If
+// there are no enums associated with type T, an invalid Enum is returned.
+func RandomValue[T constraints.Integer]() Enum[T] {
+	enums := EnumsByType[T]()
+	if len(enums) == 0 {
+		return Enum[T]{}
+	}
+
+	return enums[rand.Intn(len(enums))]
+}
@@ -0,0 +1,92 @@
+package enum
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSampler(t *testing.T) {
+	s, err := NewSampler(map[Enum[Role]]float64{
+		Enum[Role](Admin): 1,
+		Enum[Role](User):  3,
+		Enum[Role](Guest): 0,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+
+	counts := make(map[RoleEnum]int)
+	for i := 0; i < 10000; i++ {
+		counts[RoleEnum(s.Sample(r))]++
+	}
+
+	if counts[Guest] != 0 || counts[UnknownRole] != 0 {
+		t.Errorf("expected only %s and %s, got %v", Admin, User, counts)
+	}
+
+	// User should be picked about 3 times more often than Admin.
+	if ratio := float64(counts[User]) / float64(counts[Admin]); ratio < 2.5 || ratio > 3.5 {
+		t.Errorf("expected ratio close to 3, got %f", ratio)
+	}
+}
+
+func TestSampler_Reproducible(t *testing.T) {
+	weights := map[Enum[Role]]float64{
+		Enum[Role](Admin): 1,
+		Enum[Role](User):  1,
+		Enum[Role](Guest): 1,
+	}
+
+	sample := func() []Enum[Role] {
+		s, err := NewSampler(weights)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		r := rand.New(rand.NewSource(42))
+
+		values := make([]Enum[Role], 100)
+		for i := range values {
+			values[i] = s.Sample(r)
+		}
+
+		return values
+	}
+
+	a, b := sample(), sample()
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("expected same sequence for same seed, got %s and %s at %d", a[i], b[i], i)
+		}
+	}
+}
+
+func TestSampler_InvalidWeights(t *testing.T) {
+	tests := []map[Enum[Role]]float64{
+		nil,
+		{Enum[Role](Admin): 0},
+		{Enum[Role](Admin): -1},
+		{Enum[Role]{}: 1},
+	}
+
+	for _, weights := range tests {
+		if _, err := Sample(weights); err == nil {
+			t.Errorf("expected error for weights %v", weights)
+		}
+	}
+}
+
+func TestRandomValue(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		if e := RandomValue[Role](); !e.Valid() {
+			t.Fatalf("expected valid enum")
+		}
+	}
+
+	type noEnums int
+	if e := RandomValue[noEnums](); e.Valid() {
+		t.Errorf("expected invalid enum, got %s", e)
+	}
+}
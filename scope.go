@@ -0,0 +1,234 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+const (
+	// ScopeSeparator separates the elements of the names of enums used as
+	// scopes (as in "repo.read").
+	ScopeSeparator = "."
+
+	// ScopeWildcard is the element of a Scope that matches any element.
+	ScopeWildcard = "*"
+)
+
+// Scope is a permission-style scope of enums associated with type T, whose
+// names are made of elements separated by ScopeSeparator ("repo.read",
+// "repo.admin.write"). It is either the name of one of the enums or a pattern
+// with ScopeWildcard elements: a wildcard matches any single element, except
+// a trailing one, which matches one or more elements. For example, "repo.*"
+// matches "repo.read" and "repo.admin.write" (but not "repo"), "*.read"
+// matches "repo.read" and "org.read" and "*" matches every enum.
+//
+// Scopes are encoded as text (as in JSON strings). The zero value matches no
+// enum.
+type Scope[T constraints.Integer] struct {
+	pattern string
+	// elements is nil unless the pattern has wildcards.
+	elements []string
+}
+
+// ParseScope returns the Scope with the given name or pattern. This returns a
+// non-nil error if a name is not the name of an enum associated with type T,
+// if a pattern has empty elements or elements that mix wildcards with other
+// characters ("repo.re*") or if it matches none of the enums, so misspelled
+// scopes are caught when they are parsed instead of silently granting
+// nothing:
+//
+//	granted, err := enum.ParseScopes[Permission](strings.Fields(token.Scope))
+//	if err != nil {
+//		return err
+//	}
+//
+//	if !granted.Matches(RepoRead) {
+//		return errForbidden
+//	}
+func ParseScope[T constraints.Integer](s string) (Scope[T], error) {
+	elements := strings.Split(s, ScopeSeparator)
+
+	wildcard := false
+	for _, elem := range elements {
+		switch {
+		case elem == "":
+			return Scope[T]{}, fmt.Errorf("invalid scope %q of type %s: empty element", s, getTypeName[T]())
+		case elem == ScopeWildcard:
+			wildcard = true
+		case strings.Contains(elem, ScopeWildcard):
+			return Scope[T]{}, fmt.Errorf("invalid scope %q of type %s: wildcard in element %q", s, getTypeName[T](), elem)
+		}
+	}
+
+	if !wildcard {
+		e, err := EnumByTypeAndName[T](s)
+		if err != nil {
+			return Scope[T]{}, err
+		}
+
+		return Scope[T]{pattern: e.Name()}, nil
+	}
+
+	scope := Scope[T]{pattern: s, elements: elements}
+	if len(scope.Expand()) == 0 {
+		return Scope[T]{}, fmt.Errorf("scope %q of type %s matches no enums", s, getTypeName[T]())
+	}
+
+	return scope, nil
+}
+
+// MustParseScope is like ParseScope but panics on errors.
+func MustParseScope[T constraints.Integer](s string) Scope[T] {
+	scope, err := ParseScope[T](s)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return scope
+}
+
+// ScopeOf returns the Scope that only matches the given enum.
+func ScopeOf[E EnumType[T], T constraints.Integer](value E) Scope[T] {
+	w := struct{ internalEnumWrapper[T] }(value).internalEnumWrapper
+	if !w.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return Scope[T]{pattern: w.name}
+}
+
+// String returns the name or pattern of this Scope.
+func (s Scope[T]) String() string {
+	return s.pattern
+}
+
+// IsWildcard returns true if this Scope is a pattern with wildcards.
+func (s Scope[T]) IsWildcard() bool {
+	return s.elements != nil
+}
+
+// Matches returns true if the given enum is in this Scope.
+func (s Scope[T]) Matches(value Member[T]) bool {
+	w := value.wrapper()
+	if !w.Valid() || s.pattern == "" {
+		return false
+	}
+
+	if s.elements == nil {
+		return w.name == s.pattern
+	}
+
+	return matchScope(s.elements, strings.Split(w.name, ScopeSeparator))
+}
+
+// Covers returns true if every enum that other matches is also matched by
+// this Scope (for example, "repo.*" covers "repo.admin.*"), which is useful
+// to check that a delegated scope does not exceed the one it is derived from.
+func (s Scope[T]) Covers(other Scope[T]) bool {
+	for _, e := range other.Expand() {
+		if !s.Matches(e) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Expand returns the enums associated with type T (as returned by
+// EnumsByType) that are in this Scope, sorted by ID.
+func (s Scope[T]) Expand() []Enum[T] {
+	var enums []Enum[T]
+	for _, e := range EnumsByType[T]() {
+		if s.Matches(e) {
+			enums = append(enums, e)
+		}
+	}
+
+	return enums
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (s Scope[T]) MarshalText() ([]byte, error) {
+	return []byte(s.pattern), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *Scope[T]) UnmarshalText(text []byte) error {
+	scope, err := ParseScope[T](string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = scope
+
+	return nil
+}
+
+// matchScope returns true if the elements of a name match the elements of a
+// pattern.
+func matchScope(pattern, name []string) bool {
+	for i, elem := range pattern {
+		if i == len(name) {
+			return false
+		}
+
+		if elem != ScopeWildcard {
+			if elem != name[i] {
+				return false
+			}
+
+			continue
+		}
+
+		if i == len(pattern)-1 {
+			return true
+		}
+	}
+
+	return len(pattern) == len(name)
+}
+
+// Scopes is a list of scopes of enums associated with type T, such as the
+// scopes granted to a token.
+type Scopes[T constraints.Integer] []Scope[T]
+
+// ParseScopes returns the scopes with the given names or patterns (see
+// ParseScope).
+func ParseScopes[T constraints.Integer](names []string) (Scopes[T], error) {
+	scopes := make(Scopes[T], 0, len(names))
+	for i, name := range names {
+		scope, err := ParseScope[T](name)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+
+		scopes = append(scopes, scope)
+	}
+
+	return scopes, nil
+}
+
+// Matches returns true if the given enum is in any of the scopes.
+func (s Scopes[T]) Matches(value Member[T]) bool {
+	for _, scope := range s {
+		if scope.Matches(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Expand returns the set of enums that are in any of the scopes.
+func (s Scopes[T]) Expand() Set[T] {
+	var set Set[T]
+	for _, scope := range s {
+		for _, e := range scope.Expand() {
+			set.Add(e)
+		}
+	}
+
+	return set
+}
@@ -0,0 +1,125 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestScope(t *testing.T) {
+	type permission int
+
+	var (
+		repo       = New[permission]("repo")
+		repoRead   = New[permission]("repo.read")
+		repoWrite  = New[permission]("repo.write")
+		adminWrite = New[permission]("repo.admin.write")
+		orgRead    = New[permission]("org.read")
+		all        = []Enum[permission]{repo, repoRead, repoWrite, adminWrite, orgRead}
+	)
+
+	tests := []struct {
+		scope    string
+		expected []Enum[permission]
+	}{
+		{"repo", []Enum[permission]{repo}},
+		{"repo.read", []Enum[permission]{repoRead}},
+		{"repo.*", []Enum[permission]{repoRead, repoWrite, adminWrite}},
+		{"repo.*.write", []Enum[permission]{adminWrite}},
+		{"*.read", []Enum[permission]{repoRead, orgRead}},
+		{"*", all},
+	}
+
+	for _, test := range tests {
+		scope, err := ParseScope[permission](test.scope)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if scope.String() != test.scope || scope.IsWildcard() != strings.Contains(test.scope, "*") {
+			t.Errorf("unexpected scope %s", scope)
+		}
+
+		if expanded := scope.Expand(); !Equal(expanded, test.expected) {
+			t.Errorf("expected %s to expand to %v, got %v", test.scope, test.expected, expanded)
+		}
+
+		for _, e := range all {
+			if matches := scope.Matches(e); matches != NewSet(test.expected...).Contains(e) {
+				t.Errorf("expected %s matches %s to be %v", test.scope, e, !matches)
+			}
+		}
+	}
+
+	if ScopeOf(repoRead).String() != "repo.read" || !ScopeOf(repoRead).Matches(repoRead) {
+		t.Errorf("unexpected scope of %s", repoRead)
+	}
+
+	if (Scope[permission]{}).Matches(repo) || (Scope[permission]{}).Matches(Enum[permission]{}) {
+		t.Errorf("expected the zero scope to match nothing")
+	}
+
+	if !MustParseScope[permission]("repo.*").Covers(MustParseScope[permission]("repo.*.write")) ||
+		MustParseScope[permission]("repo.*").Covers(MustParseScope[permission]("*.read")) {
+		t.Errorf("unexpected Covers")
+	}
+}
+
+func TestParseScope_Errors(t *testing.T) {
+	type permission int
+
+	New[permission]("repo.read")
+
+	for _, name := range []string{"", "repo.", "repo..read", "repo.re*", "repo.write", "org.*", "*.*.*"} {
+		if _, err := ParseScope[permission](name); err == nil {
+			t.Errorf("expected error for %q, got nil", name)
+		}
+	}
+
+	expectPanic(t, func() {
+		MustParseScope[permission]("org.*")
+	})
+}
+
+func TestScopes(t *testing.T) {
+	type permission int
+
+	var (
+		repoRead  = New[permission]("repo.read")
+		repoWrite = New[permission]("repo.write")
+		orgRead   = New[permission]("org.read")
+	)
+
+	var granted struct {
+		Scopes Scopes[permission] `json:"scopes"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"scopes": ["repo.*", "org.read"]}`), &granted); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !granted.Scopes.Matches(repoWrite) || !granted.Scopes.Matches(orgRead) {
+		t.Errorf("expected %v to match", granted.Scopes)
+	}
+
+	if expanded := granted.Scopes.Expand(); !Equal(expanded.Values(), []Enum[permission]{repoRead, repoWrite, orgRead}) {
+		t.Errorf("unexpected expansion %v", expanded.Values())
+	}
+
+	data, err := json.Marshal(granted)
+	if err != nil || string(data) != `{"scopes":["repo.*","org.read"]}` {
+		t.Errorf("unexpected JSON %s (%v)", data, err)
+	}
+
+	_, err = ParseScopes[permission]([]string{"repo.read", "org.*.write"})
+
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Errorf("expected an IndexError at index 1, got %v", err)
+	}
+
+	if granted := (Scopes[permission]{ScopeOf(orgRead)}); granted.Matches(repoRead) {
+		t.Errorf("expected %v not to match %s", granted, repoRead)
+	}
+}
@@ -1,26 +1,111 @@
 package enum
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"strings"
+	"sync"
 	"sync/atomic"
 
 	"golang.org/x/exp/constraints"
 )
 
+// maxUnrecognized is the maximum number of unrecognized enums that can be
+// dynamically added to a set. This prevents unbounded memory growth when open
+// types are used to decode untrusted inputs.
+const maxUnrecognized = 1024
+
 // internalSet collects all enums associated with a specific type T.
 type internalSet[T constraints.Integer] struct {
+	// used is set to 1 when enums in the set are first looked up or
+	// marshaled. Atomically updated.
+	used uint32
+
+	// lifecycleEnabled is set to 1 when lifecyclePolicies is not empty.
+	// Atomically updated.
+	lifecycleEnabled uint32
+
+	mu sync.RWMutex
+
 	nameEnumMap map[string]*internalEnum[T]
+	idEnumMap   map[T]*internalEnum[T]
+
+	// lookup holds the *perfectLookup of nameEnumMap once the set is frozen.
+	lookup atomic.Value
+
+	// enums contains all registered (not unrecognized) enums in registration
+	// order.
+	enums []*internalEnum[T]
+
+	// declared contains the enums created by Declare that are not resolved
+	// yet.
+	declared []*internalEnum[T]
+
+	// replacements maps retired enums to their replacements.
+	replacements map[*internalEnum[T]]*internalEnum[T]
+
+	// successors maps superseded enums to their successors.
+	successors map[*internalEnum[T]]*internalEnum[T]
+
+	// normalizer, if set, is applied to names before they are used as keys in
+	// nameEnumMap.
+	normalizer func(string) string
+
+	// zero is the enum reported as zero by IsZero, in addition to invalid
+	// ones (see SetZeroValue).
+	zero *internalEnum[T]
+
+	// deprecated is the policy for marshaling deprecated enums and
+	// deprecatedHook the function called for them by deprecatedWarn.
+	deprecated     deprecatedPolicy
+	deprecatedHook func(Value)
 
-	nextID      int64 // Atomically updated.
-	exhaustedID bool  // Set to true when there are no more IDs available.
+	// lifecyclePolicies are the policies for decoding enums by lifecycle
+	// stage (see RejectLifecycle). Stages without a policy are accepted.
+	lifecyclePolicies map[Lifecycle]lifecyclePolicy
+
+	// visibility, if set, returns false for enums hidden in a context (see
+	// SetVisibility).
+	visibility func(context.Context, Enum[T]) bool
+
+	// payloads maps enums to the types of their payloads (see Bind).
+	payloads map[*internalEnum[T]]reflect.Type
+
+	// legacyIDs maps IDs used by legacy systems to current IDs (see Remap).
+	legacyIDs map[T]T
+
+	// profiles are the encoding profiles by name.
+	profiles map[string]Profile
+
+	// renamed caches the enums by the names converted by the Rename
+	// function of each profile (see GetRenamed).
+	renamed map[string]renamedEnums[T]
+
+	// typeTags are the tags associated with the set (see TagType).
+	typeTags []string
+
+	// identityKeys are the metadata keys that are part of the identity of
+	// the enums (see IdentityMetadata).
+	identityKeys []string
+
+	nextID       int64
+	exhaustedID  bool // Set to true when there are no more IDs available.
+	open         bool // Set to true if unknown names should be tracked.
+	unrecognized int  // Number of unrecognized enums.
+	frozen       bool // Set to true when no more enums can be added.
+	rejectLate   bool // Set to true if enums can not be added after first use.
+	explicitIDs  bool // Set to true if enums must be added with explicit IDs.
+	charEncoded  bool // Set to true if enums are encoded as the character of their ID.
+	gaps         gapPolicy
+	unknownName  string // Name of the enum with ID 0, if required.
 }
 
 // newInternalSet returns a new empty set.
 func newInternalSet[T constraints.Integer]() *internalSet[T] {
 	return &internalSet[T]{
-		make(map[string]*internalEnum[T]),
-		0,
-		false,
+		nameEnumMap: make(map[string]*internalEnum[T]),
+		idEnumMap:   make(map[T]*internalEnum[T]),
 	}
 }
 
@@ -28,45 +113,809 @@ func newInternalSet[T constraints.Integer]() *internalSet[T] {
 // auto-generated based on the instantiation order of enums. This panics if
 // an attempt is made to add an enum with a name that already exists in the
 // set.
-func (s *internalSet[T]) Add(name string) *internalEnum[T] {
-	if s.exhaustedID {
-		// Run out of IDs.
-		panic("too many enums in enum set")
+func (s *internalSet[T]) Add(name string, attrs attributes) *internalEnum[T] {
+	e := s.register(name, attrs, nil, nil)
+	emit(EventRegistered, e)
+
+	return e
+}
+
+// AddWithID is like Add but uses the given ID instead of an auto-generated
+// one. This panics if the ID is already used by another enum in the set.
+func (s *internalSet[T]) AddWithID(name string, id T, attrs attributes) *internalEnum[T] {
+	e := s.register(name, attrs, &id, nil)
+	emit(EventRegistered, e)
+
+	return e
+}
+
+// AddDeclared adds the given enum created by Declare to the set, using its
+// hashed ID if the HashID option was given or the next available ID
+// otherwise.
+func (s *internalSet[T]) AddDeclared(e *internalEnum[T]) {
+	if e.hashID {
+		id := hashID[T](e.name)
+		s.register(e.name, e.attributes, &id, e)
+	} else {
+		s.register(e.name, e.attributes, nil, e)
+	}
+
+	emit(EventRegistered, e)
+}
+
+// register implements Add, AddWithID and AddDeclared. If id is nil, the next
+// available ID is used. If declared is not nil, it is updated and registered
+// instead of a new enum.
+func (s *internalSet[T]) register(name string, attrs attributes, id *T, declared *internalEnum[T]) *internalEnum[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	original := name
+
+	name = s.key(name)
+	if name == "" {
+		panic(s.registrationFailure("enum name cannot be empty after normalization", original, attrs))
+	}
+
+	name = intern(name)
+
+	if other, ok := s.nameEnumMap[name]; ok {
+		panic(s.registrationFailure("duplicate name in enum set"+other.registeredAtSuffix(), name, attrs))
+	}
+
+	if s.frozen {
+		panic(s.registrationFailure("enum set is frozen", name, attrs))
+	}
+
+	if (s.rejectLate || debugMode) && atomic.LoadUint32(&s.used) != 0 {
+		panic(s.registrationFailure("enum registered after enums of the same type were already looked up or marshaled "+
+			"(this usually indicates an initialization order bug)", name, attrs))
+	}
+
+	if id == nil && s.explicitIDs {
+		panic(s.registrationFailure(fmt.Sprintf("enum %s registered without an explicit ID (see RequireExplicitIDs)", name), name, attrs))
+	}
+
+	var e *internalEnum[T]
+
+	nextID, exhaustedID := s.nextID, s.exhaustedID
+
+	if id == nil {
+		var err error
+		if e, err = s.add(name); err != nil {
+			panic(s.registrationFailure(err.Error(), name, attrs))
+		}
+
+		e.autoID = true
+	} else {
+		if other, ok := s.idEnumMap[*id]; ok {
+			panic(s.registrationFailure(fmt.Sprintf("duplicate id %d in enum set", *id)+other.registeredAtSuffix(), name, attrs))
+		}
+
+		e = s.insert(name, *id)
 	}
 
-	if _, ok := s.nameEnumMap[name]; ok {
-		panic("duplicate name in enum set")
+	var violation string
+
+	switch {
+	case s.gaps == gapsForbidden && !s.extendsRange(e.id):
+		violation = fmt.Sprintf("id %d of enum %s leaves a gap in the ids of type %s (see RequireContiguous)",
+			e.id, name, getTypeName[T]())
+	case s.unknownName != "" && (e.id == 0) != (name == s.key(s.unknownName)):
+		violation = fmt.Sprintf("enum %s of type %s has ID %d but ID 0 is reserved for %s (see RequireUnknownZero)",
+			name, getTypeName[T](), e.id, s.unknownName)
 	}
 
-	// Reserve one ID for us and update nextID.
-	id := atomic.AddInt64(&s.nextID, 1)
-	newID := id - 1
+	if violation != "" {
+		delete(s.nameEnumMap, name)
+		delete(s.idEnumMap, e.id)
+		s.nextID, s.exhaustedID = nextID, exhaustedID
+
+		panic(s.registrationFailure(violation, name, attrs))
+	}
 
-	if T(newID) > T(id) {
-		// As we always increment by one, it is guaranteed that we will see the
-		// moment id wraps around. If Add() is being called by multiple threads,
-		// it is possible that some of those threads will not notice the wrap
-		// around but this does not matter as some other thread is still
-		// guaranteed to hit this panic here.
-		//
-		// We mark IDs as exhausthed as the one we just generated is valid.
-		s.exhaustedID = true
+	if declared != nil {
+		*declared = *e
+		s.nameEnumMap[name], s.idEnumMap[e.id] = declared, declared
+		e = declared
 	}
 
+	e.attributes = attrs
+
+	s.enums = append(s.enums, e)
+
+	return e
+}
+
+// AddUnrecognized adds a new unrecognized enum with the given name to the set
+// and returns it. If an enum with the given name already exists, it is
+// returned instead.
+func (s *internalSet[T]) AddUnrecognized(name string) (*internalEnum[T], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name = s.key(name)
+
+	if e, ok := s.nameEnumMap[name]; ok {
+		// Added concurrently.
+		return e, nil
+	}
+
+	if s.unrecognized >= maxUnrecognized {
+		return nil, fmt.Errorf("too many unrecognized enums in enum set")
+	}
+
+	e, err := s.add(name)
+	if err != nil {
+		return nil, err
+	}
+
+	e.unrecognized = true
+	s.unrecognized++
+
+	return e, nil
+}
+
+// key returns the key used in nameEnumMap for the given name. It must be called
+// with the lock held.
+func (s *internalSet[T]) key(name string) string {
+	if s.normalizer == nil {
+		return name
+	}
+
+	return s.normalizer(name)
+}
+
+// SetNormalizer sets the function applied to names before they are used for
+// registration or lookup. Existing enums are re-keyed. This returns a non-nil
+// error if two existing enums would have the same normalized name.
+func (s *internalSet[T]) SetNormalizer(normalizer func(string) string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	nameEnumMap := make(map[string]*internalEnum[T], len(s.nameEnumMap))
+	for _, e := range s.nameEnumMap {
+		key := e.name
+		if normalizer != nil {
+			key = normalizer(key)
+		}
+
+		if other, ok := nameEnumMap[key]; ok {
+			return fmt.Errorf("names %q and %q are the same after normalization", other.name, e.name)
+		}
+
+		nameEnumMap[key] = e
+	}
+
+	s.nameEnumMap = nameEnumMap
+	s.normalizer = normalizer
+
+	if s.frozen {
+		s.buildPerfectLookup()
+	}
+
+	return nil
+}
+
+// add creates a new enum with the given name and the next available ID and
+// adds it to the name and ID maps. IDs explicitly used by other enums are
+// skipped. It must be called with the lock held.
+func (s *internalSet[T]) add(name string) (*internalEnum[T], error) {
+	for {
+		if s.exhaustedID {
+			// Run out of IDs.
+			return nil, fmt.Errorf("too many enums in enum set")
+		}
+
+		newID := s.nextID
+		s.nextID++
+
+		if T(newID) > T(s.nextID) {
+			// As we always increment by one, it is guaranteed that we will see
+			// the moment the ID wraps around.
+			//
+			// We mark IDs as exhausthed as the one we just generated is valid.
+			s.exhaustedID = true
+		}
+
+		if _, ok := s.idEnumMap[T(newID)]; !ok {
+			return s.insert(name, T(newID)), nil
+		}
+	}
+}
+
+// insert creates a new enum with the given name and ID and adds it to the name
+// and ID maps. It must be called with the lock held.
+func (s *internalSet[T]) insert(name string, id T) *internalEnum[T] {
 	e := &internalEnum[T]{
 		name: name,
-		id:   T(newID),
+		id:   id,
+		set:  s,
 	}
 
 	s.nameEnumMap[name] = e
+	s.idEnumMap[id] = e
 
 	return e
 }
 
+// Preallocate makes sure the set has room for at least n enums without
+// further allocations.
+func (s *internalSet[T]) Preallocate(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if n <= len(s.nameEnumMap) {
+		return
+	}
+
+	nameEnumMap := make(map[string]*internalEnum[T], n)
+	for name, e := range s.nameEnumMap {
+		nameEnumMap[name] = e
+	}
+
+	s.nameEnumMap = nameEnumMap
+
+	idEnumMap := make(map[T]*internalEnum[T], n)
+	for id, e := range s.idEnumMap {
+		idEnumMap[id] = e
+	}
+
+	s.idEnumMap = idEnumMap
+
+	if n > cap(s.enums) {
+		enums := make([]*internalEnum[T], len(s.enums), n)
+		copy(enums, s.enums)
+
+		s.enums = enums
+	}
+}
+
+// Registered returns true if e is the instance registered in the set for its
+// name.
+func (s *internalSet[T]) Registered(e *internalEnum[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.nameEnumMap[s.key(e.name)] == e
+}
+
+// MarkUsed marks the set as used (see RejectLateRegistration).
+func (s *internalSet[T]) MarkUsed() {
+	if atomic.LoadUint32(&s.used) == 0 {
+		atomic.StoreUint32(&s.used, 1)
+	}
+}
+
+// Freeze prevents any other enums from being added to the set. Unrecognized
+// enums can still be added. Registered names are looked up in a perfectLookup
+// afterwards.
+func (s *internalSet[T]) Freeze() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.frozen = true
+	s.buildPerfectLookup()
+}
+
+// Frozen returns true if the set is frozen.
+func (s *internalSet[T]) Frozen() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.frozen
+}
+
+// RejectLateRegistration prevents enums from being added to the set after it
+// was used.
+func (s *internalSet[T]) RejectLateRegistration() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rejectLate = true
+}
+
+// SetProfile sets the encoding profile with the given name.
+func (s *internalSet[T]) SetProfile(name string, p Profile) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.profiles == nil {
+		s.profiles = make(map[string]Profile)
+	}
+
+	s.profiles[name] = p
+	delete(s.renamed, name)
+}
+
+// renamedEnums are the enums of a set by name converted by a profile.
+type renamedEnums[T constraints.Integer] struct {
+	// count is the number of enums in the set when the map was built.
+	count int
+
+	byName map[string]*internalEnum[T]
+}
+
+// GetRenamed returns the enum whose name is converted to the given name by the
+// Rename function of the given profile, or nil if there is none. Unrecognized
+// enums are not included.
+func (s *internalSet[T]) GetRenamed(profile string, p Profile, name string) *internalEnum[T] {
+	// Enums are never removed from the set, so the map is up to date as long
+	// as no enum was added.
+	s.mu.RLock()
+	r, ok := s.renamed[profile]
+	fresh := ok && r.count == len(s.enums)
+	s.mu.RUnlock()
+
+	if !fresh {
+		s.mu.Lock()
+
+		r = renamedEnums[T]{count: len(s.enums), byName: make(map[string]*internalEnum[T], len(s.enums))}
+		for _, e := range s.enums {
+			if !e.unrecognized {
+				r.byName[p.Rename(e.name)] = e
+			}
+		}
+
+		if s.renamed == nil {
+			s.renamed = make(map[string]renamedEnums[T])
+		}
+
+		s.renamed[profile] = r
+
+		s.mu.Unlock()
+	}
+
+	return r.byName[name]
+}
+
+// Profile returns the encoding profile with the given name and true, or false
+// if there is none.
+func (s *internalSet[T]) Profile(name string) (Profile, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, ok := s.profiles[name]
+
+	return p, ok
+}
+
+// RequireExplicitIDs prevents enums from being added to the set without an
+// explicit ID. This returns a non-nil error if enums with auto-generated IDs
+// were already added.
+func (s *internalSet[T]) RequireExplicitIDs() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range s.enums {
+		if e.autoID {
+			return fmt.Errorf("enum %s was registered without an explicit ID%s", e.name, e.registeredAtSuffix())
+		}
+	}
+
+	s.explicitIDs = true
+
+	return nil
+}
+
+// ExplicitIDs returns true if enums can only be added with explicit IDs.
+func (s *internalSet[T]) ExplicitIDs() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.explicitIDs
+}
+
+// All returns all registered enums in registration order. Unrecognized and
+// retired enums are not included.
+func (s *internalSet[T]) All() []*internalEnum[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	enums := make([]*internalEnum[T], 0, len(s.enums)-len(s.replacements))
+	for _, e := range s.enums {
+		if _, ok := s.replacements[e]; !ok {
+			enums = append(enums, e)
+		}
+	}
+
+	return enums
+}
+
+// Retire marks the given enum as retired and associates it with the given
+// replacement.
+func (s *internalSet[T]) Retire(e, replacement *internalEnum[T]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.nameEnumMap[s.key(e.name)] != e || s.nameEnumMap[s.key(replacement.name)] != replacement {
+		return fmt.Errorf("enum not registered")
+	}
+
+	if e.unrecognized || replacement.unrecognized {
+		return fmt.Errorf("unrecognized enums can not be retired or used as replacements")
+	}
+
+	if _, ok := s.replacements[e]; ok {
+		return fmt.Errorf("enum %s already retired", e.name)
+	}
+
+	for r := replacement; r != nil; r = s.next(r) {
+		if r == e {
+			return fmt.Errorf("enum %s can not be replaced by itself", e.name)
+		}
+	}
+
+	if s.replacements == nil {
+		s.replacements = make(map[*internalEnum[T]]*internalEnum[T])
+	}
+
+	s.replacements[e] = replacement
+
+	return nil
+}
+
+// Supersede associates the given deprecated enum with the given successor.
+func (s *internalSet[T]) Supersede(e, successor *internalEnum[T]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.nameEnumMap[s.key(e.name)] != e || s.nameEnumMap[s.key(successor.name)] != successor {
+		return fmt.Errorf("enum not registered")
+	}
+
+	if e.unrecognized || successor.unrecognized {
+		return fmt.Errorf("unrecognized enums can not be superseded or used as successors")
+	}
+
+	if !e.deprecated {
+		return fmt.Errorf("enum %s is not deprecated", e.name)
+	}
+
+	if _, ok := s.successors[e]; ok {
+		return fmt.Errorf("enum %s already superseded", e.name)
+	}
+
+	for r := successor; r != nil; r = s.next(r) {
+		if r == e {
+			return fmt.Errorf("enum %s can not be superseded by itself", e.name)
+		}
+	}
+
+	if s.successors == nil {
+		s.successors = make(map[*internalEnum[T]]*internalEnum[T])
+	}
+
+	s.successors[e] = successor
+
+	return nil
+}
+
+// Successor returns the successor of the given enum and true, or false if
+// it was not superseded.
+func (s *internalSet[T]) Successor(e *internalEnum[T]) (*internalEnum[T], bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	successor, ok := s.successors[e]
+
+	return successor, ok
+}
+
+// Canonicalize returns the current enum for the given one, following both
+// replacements and successors.
+func (s *internalSet[T]) Canonicalize(e *internalEnum[T]) *internalEnum[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for next := s.next(e); next != nil; next = s.next(e) {
+		e = next
+	}
+
+	return e
+}
+
+// next returns the replacement of the given enum if it was retired, its
+// successor if it was superseded or nil. It must be called with the lock
+// held.
+func (s *internalSet[T]) next(e *internalEnum[T]) *internalEnum[T] {
+	if r, ok := s.replacements[e]; ok {
+		return r
+	}
+
+	return s.successors[e]
+}
+
+// Retired returns true if the given enum was retired.
+func (s *internalSet[T]) Retired(e *internalEnum[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.replacements[e]
+
+	return ok
+}
+
+// Resolve returns the enum that should be used in place of the given one. This
+// is the given enum itself unless it was retired, in which case it is its
+// (last) replacement.
+func (s *internalSet[T]) Resolve(e *internalEnum[T]) *internalEnum[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.resolveLocked(e)
+}
+
+// resolveLocked is like Resolve but expects the lock to be held.
+func (s *internalSet[T]) resolveLocked(e *internalEnum[T]) *internalEnum[T] {
+	for {
+		r, ok := s.replacements[e]
+		if !ok {
+			return e
+		}
+
+		e = r
+	}
+}
+
+// Open returns true if unknown names should be added to the set as
+// unrecognized enums.
+func (s *internalSet[T]) Open() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.open
+}
+
+// SetOpen sets whether unknown names should be added to the set as
+// unrecognized enums.
+func (s *internalSet[T]) SetOpen(open bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.open = open
+}
+
+// SetDeprecatedPolicy sets the policy for marshaling deprecated enums.
+func (s *internalSet[T]) SetDeprecatedPolicy(policy deprecatedPolicy, hook func(Value)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deprecated = policy
+	s.deprecatedHook = hook
+}
+
+// DeprecatedPolicy returns the policy for marshaling deprecated enums.
+func (s *internalSet[T]) DeprecatedPolicy() (deprecatedPolicy, func(Value)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.deprecated, s.deprecatedHook
+}
+
+// SetVisibility sets the function returning false for enums hidden in a
+// context.
+func (s *internalSet[T]) SetVisibility(visibility func(context.Context, Enum[T]) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.visibility = visibility
+}
+
+// Visibility returns the function returning false for enums hidden in a
+// context, or nil.
+func (s *internalSet[T]) Visibility() func(context.Context, Enum[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.visibility
+}
+
+// SetZero sets the enum reported as zero by IsZero.
+func (s *internalSet[T]) SetZero(e *internalEnum[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.zero = e
+}
+
+// Zero returns the enum reported as zero by IsZero, or nil.
+func (s *internalSet[T]) Zero() *internalEnum[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.zero
+}
+
+// Remap adds the given legacy IDs to the ones mapped to current IDs. This
+// returns a non-nil error if a legacy ID is already mapped to a different ID.
+func (s *internalSet[T]) Remap(m map[T]T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for legacy, id := range m {
+		if other, ok := s.legacyIDs[legacy]; ok && other != id {
+			return fmt.Errorf("legacy id %d of type %s already mapped to %d", legacy, getTypeName[T](), other)
+		}
+	}
+
+	if s.legacyIDs == nil {
+		s.legacyIDs = make(map[T]T, len(m))
+	}
+
+	for legacy, id := range m {
+		s.legacyIDs[legacy] = id
+	}
+
+	return nil
+}
+
+// LegacyID returns the current ID the given legacy ID is mapped to and true,
+// or false if it is not mapped.
+func (s *internalSet[T]) LegacyID(legacy T) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	id, ok := s.legacyIDs[legacy]
+
+	return id, ok
+}
+
+// RequireUnknownZero requires the enum with ID 0 to have the given name. This
+// returns a non-nil error if an already registered enum violates it or if a
+// different name was already required.
+func (s *internalSet[T]) RequireUnknownZero(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.unknownName != "" && s.unknownName != name {
+		return fmt.Errorf("ID 0 of type %s already reserved for %s", getTypeName[T](), s.unknownName)
+	}
+
+	if e, ok := s.nameEnumMap[s.key(name)]; ok && e.id != 0 {
+		return fmt.Errorf("enum %s of type %s has ID %d instead of 0", name, getTypeName[T](), e.id)
+	}
+
+	if e, ok := s.idEnumMap[0]; ok && e.name != s.key(name) {
+		return fmt.Errorf("enum %s of type %s has ID 0 instead of %s", e.name, getTypeName[T](), name)
+	}
+
+	s.unknownName = name
+
+	return nil
+}
+
+// Unknown returns the enum with ID 0 if RequireUnknownZero was called and it
+// is registered, or nil.
+func (s *internalSet[T]) Unknown() *internalEnum[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.unknownName == "" {
+		return nil
+	}
+
+	e, ok := s.idEnumMap[0]
+	if !ok || e.unrecognized {
+		return nil
+	}
+
+	return e
+}
+
+// SetGapPolicy sets the policy for gaps in the IDs of registered enums. This
+// returns a non-nil error if a different policy was already set or if gaps
+// are forbidden and the IDs of registered enums are not contiguous.
+func (s *internalSet[T]) SetGapPolicy(policy gapPolicy) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.gaps != gapsUnspecified && s.gaps != policy {
+		return fmt.Errorf("conflicting gap policies for type %s", getTypeName[T]())
+	}
+
+	if policy == gapsForbidden {
+		lo, hi := s.idRange()
+		if len(s.enums) > 0 && uint64(hi)-uint64(lo) != uint64(len(s.enums)-1) {
+			return fmt.Errorf("ids of enums for type %s are not contiguous", getTypeName[T]())
+		}
+	}
+
+	s.gaps = policy
+
+	return nil
+}
+
+// GapPolicy returns the policy for gaps in the IDs of registered enums.
+func (s *internalSet[T]) GapPolicy() gapPolicy {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.gaps
+}
+
+// idRange returns the lowest and highest IDs of registered enums. It must be
+// called with the lock held.
+func (s *internalSet[T]) idRange() (lo, hi T) {
+	for i, e := range s.enums {
+		if i == 0 || e.id < lo {
+			lo = e.id
+		}
+
+		if i == 0 || e.id > hi {
+			hi = e.id
+		}
+	}
+
+	return lo, hi
+}
+
+// extendsRange returns true if the given ID is right below or above the IDs
+// of all registered enums, so adding it does not leave a gap. It must be
+// called with the lock held.
+func (s *internalSet[T]) extendsRange(id T) bool {
+	if len(s.enums) == 0 {
+		return true
+	}
+
+	lo, hi := s.idRange()
+
+	return (id < lo && id == lo-1) || (id > hi && id == hi+1)
+}
+
+// CharEncoded returns true if enums are encoded as the character of their
+// ID.
+func (s *internalSet[T]) CharEncoded() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.charEncoded
+}
+
+// SetCharEncoded sets whether enums are encoded as the character of their ID.
+func (s *internalSet[T]) SetCharEncoded(charEncoded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.charEncoded = charEncoded
+}
+
+// MaxID returns the highest ID of the registered enums in the set (including
+// retired ones but not unrecognized ones, see Open) and true or false if there
+// is none.
+func (s *internalSet[T]) MaxID() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var maxID T
+	var found bool
+	for _, e := range s.nameEnumMap {
+		if e.unrecognized {
+			continue
+		}
+
+		if !found || e.id > maxID {
+			maxID = e.id
+			found = true
+		}
+	}
+
+	return maxID, found
+}
+
 // Get returns the enum associated with the given name. If no enum with the
 // given name exists, this returns nil.
 func (s *internalSet[T]) Get(name string) *internalEnum[T] {
-	e, ok := s.nameEnumMap[name]
+	if l := s.perfect(); l != nil {
+		if e := l.get(name); e != nil {
+			return e
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	e, ok := s.nameEnumMap[s.key(name)]
 	if !ok {
 		return nil
 	}
@@ -74,10 +923,51 @@ func (s *internalSet[T]) Get(name string) *internalEnum[T] {
 	return e
 }
 
+// GetBytes is like Get but takes the name as a byte slice. It does not
+// allocate unless there is a normalizer.
+func (s *internalSet[T]) GetBytes(name []byte) *internalEnum[T] {
+	if l := s.perfect(); l != nil {
+		if e := l.getBytes(name); e != nil {
+			return e
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.normalizer != nil {
+		return s.nameEnumMap[s.normalizer(string(name))]
+	}
+
+	return s.nameEnumMap[string(name)]
+}
+
+// GetFold is like Get but matches names case-insensitively. Exact matches are
+// always preferred. If names of several enums only differ by case, the first
+// registered one is returned.
+func (s *internalSet[T]) GetFold(name string) *internalEnum[T] {
+	if e := s.Get(name); e != nil {
+		return e
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	name = s.key(name)
+	for _, e := range s.enums {
+		key := s.key(e.name)
+		if strings.EqualFold(key, name) && s.nameEnumMap[key] == e {
+			return e
+		}
+	}
+
+	return nil
+}
+
 // GetByName returns the Enum associated with the given name and type T.
 func (s *internalSet[T]) GetByName(name string) (*internalEnum[T], error) {
-	e, ok := s.nameEnumMap[name]
-	if !ok {
+	e := s.Get(name)
+	if e == nil {
 		return nil, fmt.Errorf("name %s could not be found in set", name)
 	}
 
@@ -86,11 +976,46 @@ func (s *internalSet[T]) GetByName(name string) (*internalEnum[T], error) {
 
 // GetByID returns the Enum associated with the given ID and type T.
 func (s *internalSet[T]) GetByID(id T) (*internalEnum[T], error) {
-	for _, e := range s.nameEnumMap {
-		if e.id == id {
-			return e, nil
-		}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if e, ok := s.idEnumMap[id]; ok {
+		return e, nil
 	}
 
 	return nil, fmt.Errorf("id %d could not be found in set", id)
 }
+
+// Bind associates the given enum with the given payload type. This returns a
+// non-nil error if the enum is already bound.
+func (s *internalSet[T]) Bind(e *internalEnum[T], t reflect.Type) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.nameEnumMap[s.key(e.name)] != e || e.unrecognized {
+		return fmt.Errorf("enum not registered")
+	}
+
+	if other, ok := s.payloads[e]; ok {
+		return fmt.Errorf("enum %s already bound to %s", e.name, other)
+	}
+
+	if s.payloads == nil {
+		s.payloads = make(map[*internalEnum[T]]reflect.Type)
+	}
+
+	s.payloads[e] = t
+
+	return nil
+}
+
+// Payload returns the payload type the given enum is bound to and true, or
+// false if it is not bound.
+func (s *internalSet[T]) Payload(e *internalEnum[T]) (reflect.Type, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.payloads[e]
+
+	return t, ok
+}
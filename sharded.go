@@ -0,0 +1,115 @@
+package enum
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ShardedCounter is a variant of Counter for counters under very high
+// contention. Each increment only touches one of several shards (one per P,
+// approximately), so concurrent increments do not compete for the same cache
+// lines. Reads merge all shards, which makes them slower than for Counter.
+//
+// Like Counter, it handles the enums associated with type T at the time it is
+// created and accumulates increments for other enums in Other.
+type ShardedCounter[T constraints.Integer] struct {
+	shards []counterShard
+
+	// The counts in each shard are indexed as the enums in counterIndex.
+	counterIndex[T]
+
+	// pool hands out shard indices. As pools keep per-P caches, goroutines
+	// running on the same P usually get the same shard.
+	pool sync.Pool
+	next uint32
+}
+
+type counterShard struct {
+	// counts has one entry per enum plus one for other enums at the end.
+	counts []uint64
+
+	// Pad to prevent false sharing between the slice headers of shards.
+	_ [64]byte
+}
+
+// NewShardedCounter returns a new ShardedCounter for enums associated with
+// type T.
+func NewShardedCounter[T constraints.Integer]() *ShardedCounter[T] {
+	c := &ShardedCounter[T]{
+		shards:       make([]counterShard, runtime.GOMAXPROCS(0)),
+		counterIndex: newCounterIndex[T](),
+	}
+
+	for i := range c.shards {
+		// Padding at the end of each slice prevents false sharing with other
+		// allocations.
+		c.shards[i].counts = make([]uint64, len(c.enums)+1, len(c.enums)+1+8)
+	}
+
+	c.pool.New = func() any {
+		i := int(atomic.AddUint32(&c.next, 1)-1) % len(c.shards)
+		return &i
+	}
+
+	return c
+}
+
+// Inc increments the counter associated with the given enum by one.
+func (c *ShardedCounter[T]) Inc(value Member[T]) {
+	c.Add(value, 1)
+}
+
+// Add increments the counter associated with the given enum by n.
+func (c *ShardedCounter[T]) Add(value Member[T], n uint64) {
+	index := c.index(value)
+
+	shard := c.pool.Get().(*int)
+	atomic.AddUint64(&c.shards[*shard].counts[index], n)
+	c.pool.Put(shard)
+}
+
+// Get returns the current count for the given enum.
+func (c *ShardedCounter[T]) Get(value Member[T]) uint64 {
+	return c.sum(c.index(value))
+}
+
+// Other returns the sum of all increments for enums outside of the range of
+// IDs handled by this ShardedCounter.
+func (c *ShardedCounter[T]) Other() uint64 {
+	return c.sum(len(c.enums))
+}
+
+// Snapshot returns the current counts for all enums handled by this
+// ShardedCounter, including the ones that were never incremented. Like for
+// Counter, the snapshot as a whole is not read atomically.
+func (c *ShardedCounter[T]) Snapshot() map[Enum[T]]uint64 {
+	snapshot := make(map[Enum[T]]uint64, len(c.enums))
+	for i, e := range c.enums {
+		if e.Valid() {
+			snapshot[e] = c.sum(i)
+		}
+	}
+
+	return snapshot
+}
+
+func (c *ShardedCounter[T]) sum(index int) uint64 {
+	var total uint64
+	for i := range c.shards {
+		total += atomic.LoadUint64(&c.shards[i].counts[index])
+	}
+
+	return total
+}
+
+// index returns the index of the count for the given enum in each shard.
+func (c *ShardedCounter[T]) index(value Member[T]) int {
+	if i, ok := c.lookup(value); ok {
+		return i
+	}
+
+	return len(c.enums)
+}
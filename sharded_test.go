@@ -0,0 +1,88 @@
+package enum
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestShardedCounter(t *testing.T) {
+	c := NewShardedCounter[Role]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 1000; j++ {
+				c.Inc(Admin)
+				c.Add(Guest, 2)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if n := c.Get(Admin); n != 8000 {
+		t.Errorf("expected 8000, got %d", n)
+	}
+
+	snapshot := c.Snapshot()
+	if len(snapshot) != 4 || snapshot[Enum[Role](Guest)] != 16000 || snapshot[Enum[Role](User)] != 0 {
+		t.Errorf("unexpected snapshot %v", snapshot)
+	}
+
+	type open int
+
+	New[open]("Known")
+	Open[open]()
+
+	oc := NewShardedCounter[open]()
+
+	unrecognized, err := EnumByTypeAndName[open]("Other")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	oc.Inc(unrecognized)
+
+	if oc.Other() != 1 || oc.Get(unrecognized) != 1 {
+		t.Errorf("expected unrecognized enum to be counted in other")
+	}
+
+	expectPanic(t, func() {
+		c.Inc(RoleEnum{})
+	})
+}
+
+func BenchmarkShardedCounter_Inc(b *testing.B) {
+	c := NewShardedCounter[Role]()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.Inc(Admin)
+		}
+	})
+}
+
+func TestShardedCounter_SparseIDs(t *testing.T) {
+	type code int64
+
+	var (
+		ok      = NewWithID[code]("OK", 0)
+		timeout = NewWithID[code]("Timeout", 1<<40)
+	)
+
+	c := NewShardedCounter[code]()
+	c.Inc(ok)
+	c.Add(timeout, 3)
+
+	if c.Get(ok) != 1 || c.Get(timeout) != 3 || c.Other() != 0 {
+		t.Errorf("unexpected counts %v (other %d)", c.Snapshot(), c.Other())
+	}
+
+	if snapshot := c.Snapshot(); len(snapshot) != 2 || snapshot[timeout] != 3 {
+		t.Errorf("unexpected snapshot %v", snapshot)
+	}
+}
@@ -0,0 +1,318 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Slice is a list of enums associated with type T that is encoded to and
+// decoded from JSON as an array of names. When decoding, all elements are
+// validated and the returned error identifies every invalid one by index.
+type Slice[T constraints.Integer] []Enum[T]
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s Slice[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(s, false)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *Slice[T]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONArray[T](data)
+	if err != nil {
+		return err
+	}
+
+	*s = values
+
+	return nil
+}
+
+// UniqueSlice is like Slice but duplicates are removed when encoding and
+// decoding, keeping the first occurrence of each enum. Contrary to Set, the
+// order of the enums is kept.
+type UniqueSlice[T constraints.Integer] []Enum[T]
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s UniqueSlice[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(s, true)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *UniqueSlice[T]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONArray[T](data)
+	if err != nil {
+		return err
+	}
+
+	*s = unique(values)
+
+	return nil
+}
+
+// unique removes duplicates from the given enums in place, keeping the first
+// occurrence of each.
+func unique[T constraints.Integer](values []Enum[T]) []Enum[T] {
+	if values == nil {
+		return nil
+	}
+
+	seen := make(map[T]bool, len(values))
+
+	n := 0
+	for _, v := range values {
+		if !seen[v.ID()] {
+			seen[v.ID()] = true
+			values[n] = v
+			n++
+		}
+	}
+
+	return values[:n]
+}
+
+// Set is a set of enums associated with type T. It is encoded to and decoded
+// from JSON as an array of names (sorted by ID when encoding and with
+// duplicates removed when decoding). The zero value is an empty set ready to
+// use. Sets are not safe for concurrent modification.
+type Set[T constraints.Integer] struct {
+	// Keyed by ID so copies of the enums are also handled.
+	values map[T]Enum[T]
+}
+
+// NewSet returns a new Set containing the given enums.
+func NewSet[T constraints.Integer](values ...Enum[T]) Set[T] {
+	var s Set[T]
+	for _, v := range values {
+		s.Add(v)
+	}
+
+	return s
+}
+
+// Add adds the given enum to the set. This panics if the enum is not valid.
+func (s *Set[T]) Add(value Member[T]) {
+	e := Enum[T]{value.wrapper()}
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if s.values == nil {
+		s.values = make(map[T]Enum[T])
+	}
+
+	s.values[e.ID()] = e
+}
+
+// Remove removes the given enum from the set.
+func (s *Set[T]) Remove(value Member[T]) {
+	if w := value.wrapper(); w.Valid() {
+		delete(s.values, w.id)
+	}
+}
+
+// Contains returns true if the given enum is in the set.
+func (s Set[T]) Contains(value Member[T]) bool {
+	w := value.wrapper()
+	if !w.Valid() {
+		return false
+	}
+
+	_, ok := s.values[w.id]
+
+	return ok
+}
+
+// Len returns the number of enums in the set.
+func (s Set[T]) Len() int {
+	return len(s.values)
+}
+
+// Values returns the enums in the set sorted by ID.
+func (s Set[T]) Values() []Enum[T] {
+	values := make([]Enum[T], 0, len(s.values))
+	for _, e := range s.values {
+		values = append(values, e)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].ID() < values[j].ID()
+	})
+
+	return values
+}
+
+// IsSubsetOf returns true if all enums in this set are also in other.
+func (s Set[T]) IsSubsetOf(other Set[T]) bool {
+	if len(s.values) > len(other.values) {
+		return false
+	}
+
+	for id := range s.values {
+		if _, ok := other.values[id]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSupersetOf returns true if all enums in other are also in this set.
+func (s Set[T]) IsSupersetOf(other Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// Difference returns a new set with the enums in this set that are not in
+// other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	var d Set[T]
+	for id, e := range s.values {
+		if _, ok := other.values[id]; !ok {
+			d.Add(e)
+		}
+	}
+
+	return d
+}
+
+// SymmetricDifference returns a new set with the enums that are in either
+// this set or other but not in both.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	d := s.Difference(other)
+	for id, e := range other.values {
+		if _, ok := s.values[id]; !ok {
+			d.Add(e)
+		}
+	}
+
+	return d
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(s.Values(), false)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONArray[T](data)
+	if err != nil {
+		return err
+	}
+
+	*s = NewSet(values...)
+
+	return nil
+}
+
+// Normalize returns a new slice with the given enums sorted by ID and with
+// duplicates removed. The given slice is not modified. This panics if any of
+// the enums is not valid.
+func Normalize[T constraints.Integer](values []Enum[T]) []Enum[T] {
+	normalized := make([]Enum[T], len(values))
+	copy(normalized, values)
+
+	sort.Slice(normalized, func(i, j int) bool {
+		return normalized[i].ID() < normalized[j].ID()
+	})
+
+	n := 0
+	for i, v := range normalized {
+		if i > 0 && v.ID() == normalized[n-1].ID() {
+			continue
+		}
+
+		normalized[n] = v
+		n++
+	}
+
+	return normalized[:n]
+}
+
+// Equal returns true if both slices contain the same enums, regardless of
+// order and duplicates. This panics if any of the enums is not valid.
+func Equal[T constraints.Integer](a, b []Enum[T]) bool {
+	na, nb := Normalize(a), Normalize(b)
+	if len(na) != len(nb) {
+		return false
+	}
+
+	for i := range na {
+		if na[i].ID() != nb[i].ID() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// marshalJSONArray encodes the given enums like MarshalJSON does (see
+// RejectDeprecated and CharEncoded), skipping enums marshaled as a previous
+// one if unique is true.
+func marshalJSONArray[T constraints.Integer](values []Enum[T], unique bool) ([]byte, error) {
+	if values == nil {
+		return []byte("null"), nil
+	}
+
+	var seen map[T]bool
+	if unique {
+		seen = make(map[T]bool, len(values))
+	}
+
+	data := append(make([]byte, 0, 16*len(values)+2), '[')
+	for i, v := range values {
+		if !v.Valid() {
+			return nil, fmt.Errorf("enum at index %d not initialized", i)
+		}
+
+		m, err := v.marshaled()
+		if err != nil {
+			return nil, fmt.Errorf("enum at index %d: %w", i, err)
+		}
+
+		if unique {
+			if seen[m.id] {
+				continue
+			}
+
+			seen[m.id] = true
+		}
+
+		if len(data) > 1 {
+			data = append(data, ',')
+		}
+
+		data = appendJSONString(data, m.encodedName())
+	}
+
+	return append(data, ']'), nil
+}
+
+func unmarshalJSONArray[T constraints.Integer](data []byte) ([]Enum[T], error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("source should be an array, got %s", data)
+	}
+
+	if raw == nil {
+		return nil, nil
+	}
+
+	var errs FieldErrors
+
+	values := make([]Enum[T], len(raw))
+	for i, r := range raw {
+		if err := values[i].UnmarshalJSON(r); err != nil {
+			errs = append(errs, &FieldError{"[" + strconv.Itoa(i) + "]", err})
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return values, nil
+}
@@ -0,0 +1,215 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestSlice_JSON(t *testing.T) {
+	var s Slice[Permission]
+	if err := json.Unmarshal([]byte(`["Write", "Read", "Write"]`), &s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(s) != 3 || PermissionEnum(s[0]) != Write || PermissionEnum(s[1]) != Read {
+		t.Errorf("expected [Write Read Write], got %v", s)
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(data) != `["Write","Read","Write"]` {
+		t.Errorf("unexpected JSON %s", data)
+	}
+}
+
+func TestSlice_JSONErrors(t *testing.T) {
+	var s Slice[Permission]
+
+	err := json.Unmarshal([]byte(`["Read", "Execute", 1]`), &s)
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	var errs FieldErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected FieldErrors, got %v", err)
+	}
+	if len(errs) != 2 || errs[0].Path != "[1]" || errs[1].Path != "[2]" {
+		t.Errorf("expected errors for [1] and [2], got %v", errs)
+	}
+
+	if err := json.Unmarshal([]byte(`"Read"`), &s); err == nil {
+		t.Errorf("expected error for non-array source")
+	}
+}
+
+func TestUniqueSlice_JSON(t *testing.T) {
+	var s UniqueSlice[Permission]
+	if err := json.Unmarshal([]byte(`["Write", "Read", "Write"]`), &s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(s) != 2 || PermissionEnum(s[0]) != Write || PermissionEnum(s[1]) != Read {
+		t.Errorf("expected [Write Read], got %v", s)
+	}
+
+	s = append(s, Enum[Permission](Write))
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(data) != `["Write","Read"]` {
+		t.Errorf("unexpected JSON %s", data)
+	}
+}
+
+func TestSlice_MarshalPolicies(t *testing.T) {
+	type sliceStatus int
+
+	active := New[sliceStatus]("Active")
+	old := New[sliceStatus]("Old", Deprecated())
+	legacy := New[sliceStatus]("Legacy", Deprecated())
+	Retire(old, active)
+
+	ReplaceDeprecated[sliceStatus]()
+
+	data, err := json.Marshal(UniqueSlice[sliceStatus]{old, active})
+	if err != nil || string(data) != `["Active"]` {
+		t.Errorf("expected [\"Active\"], got %s (%v)", data, err)
+	}
+
+	if _, err := json.Marshal(Slice[sliceStatus]{active, legacy}); err == nil {
+		t.Errorf("expected error for a deprecated value without replacement")
+	}
+
+	if _, err := json.Marshal(NewSet(legacy)); err == nil {
+		t.Errorf("expected error for a deprecated value without replacement")
+	}
+
+	if data, err := json.Marshal(Slice[transactionType]{debit, credit}); err != nil || string(data) != `["D","C"]` {
+		t.Errorf("expected [\"D\",\"C\"], got %s (%v)", data, err)
+	}
+}
+
+func TestSet(t *testing.T) {
+	var s Set[Role]
+
+	if s.Contains(Admin) || s.Len() != 0 {
+		t.Errorf("expected empty set")
+	}
+
+	s.Add(Guest)
+	s.Add(Admin)
+	s.Add(Guest)
+
+	if !s.Contains(Admin) || !s.Contains(Guest) || s.Contains(User) {
+		t.Errorf("unexpected set contents %v", s.Values())
+	}
+	if s.Len() != 2 {
+		t.Errorf("expected 2 values, got %d", s.Len())
+	}
+
+	s.Remove(Guest)
+	if s.Contains(Guest) {
+		t.Errorf("expected %s to be removed", Guest)
+	}
+}
+
+func TestSet_JSON(t *testing.T) {
+	var s Set[Role]
+	if err := json.Unmarshal([]byte(`["Guest", "Admin", "Guest"]`), &s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// De-duplicated and sorted by ID.
+	if string(data) != `["Admin","Guest"]` {
+		t.Errorf("unexpected JSON %s", data)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	values := []Enum[Role]{Enum[Role](Guest), Enum[Role](Admin), Enum[Role](Guest), Enum[Role](UnknownRole)}
+
+	normalized := Normalize(values)
+
+	expected := []Enum[Role]{Enum[Role](UnknownRole), Enum[Role](Admin), Enum[Role](Guest)}
+	if len(normalized) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, normalized)
+	}
+	for i := range expected {
+		if normalized[i] != expected[i] {
+			t.Errorf("expected %s at index %d, got %s", expected[i], i, normalized[i])
+		}
+	}
+
+	// The original slice is not modified.
+	if RoleEnum(values[0]) != Guest {
+		t.Errorf("expected original slice to be untouched, got %v", values)
+	}
+
+	if len(Normalize[Role](nil)) != 0 {
+		t.Errorf("expected empty slice")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	a := []Enum[Permission]{Enum[Permission](Write), Enum[Permission](Read)}
+	b := []Enum[Permission]{Enum[Permission](Read), Enum[Permission](Write), Enum[Permission](Read)}
+	c := []Enum[Permission]{Enum[Permission](Read)}
+
+	if !Equal(a, b) {
+		t.Errorf("expected %v and %v to be equal", a, b)
+	}
+	if Equal(a, c) {
+		t.Errorf("expected %v and %v to not be equal", a, c)
+	}
+	if !Equal[Permission](nil, []Enum[Permission]{}) {
+		t.Errorf("expected empty slices to be equal")
+	}
+}
+
+func TestSet_SubsetSuperset(t *testing.T) {
+	required := NewSet(Enum[Permission](Read))
+	granted := NewSet(Enum[Permission](Read), Enum[Permission](Write))
+
+	if !required.IsSubsetOf(granted) || required.IsSupersetOf(granted) {
+		t.Errorf("expected %v to be a strict subset of %v", required.Values(), granted.Values())
+	}
+	if !granted.IsSupersetOf(required) || granted.IsSubsetOf(required) {
+		t.Errorf("expected %v to be a strict superset of %v", granted.Values(), required.Values())
+	}
+	if !(Set[Permission]{}).IsSubsetOf(required) {
+		t.Errorf("expected empty set to be a subset of any set")
+	}
+	if !granted.IsSubsetOf(granted) {
+		t.Errorf("expected set to be a subset of itself")
+	}
+}
+
+func TestSet_Difference(t *testing.T) {
+	a := NewSet(Enum[Role](Admin), Enum[Role](User))
+	b := NewSet(Enum[Role](User), Enum[Role](Guest))
+
+	if d := a.Difference(b).Values(); len(d) != 1 || RoleEnum(d[0]) != Admin {
+		t.Errorf("expected [Admin], got %v", d)
+	}
+
+	d := a.SymmetricDifference(b).Values()
+	if len(d) != 2 || RoleEnum(d[0]) != Admin || RoleEnum(d[1]) != Guest {
+		t.Errorf("expected [Admin Guest], got %v", d)
+	}
+
+	// Originals are untouched.
+	if a.Len() != 2 || b.Len() != 2 {
+		t.Errorf("expected original sets to be untouched")
+	}
+}
@@ -0,0 +1,122 @@
+package enum
+
+import "golang.org/x/exp/constraints"
+
+// TypeView is an immutable view of the enums associated with a type T at the
+// time it was created with Snapshot. All methods can be called concurrently
+// without any synchronization so they never block on the registry. Enums
+// registered (or retired) after the view was created are not visible.
+type TypeView[T constraints.Integer] struct {
+	values     []Enum[T]
+	byName     map[string]viewEntry[T]
+	byID       map[T]viewEntry[T]
+	metadata   map[*internalEnum[T]]map[string]string
+	normalizer func(string) string
+}
+
+// viewEntry is an enum of a TypeView that can be looked up.
+type viewEntry[T constraints.Integer] struct {
+	// registered is the enum registered with the name or ID, on which the
+	// lifecycle policy is applied (see RejectLifecycle).
+	registered *internalEnum[T]
+
+	// value is the registered enum or its replacement if it is retired.
+	value Enum[T]
+}
+
+// Snapshot returns a TypeView of the enums currently associated with type T,
+// for latency-critical code. Metadata loaders (see MetadataLoader) of all
+// enums are called, if needed, so values are available in the view. Unlike
+// the lookup functions, retired enums are resolved when the view is created
+// and unrecognized enums (see Open) are not included. Like the lookup
+// functions, Parse and FromID apply the lifecycle policy of type T.
+func Snapshot[T constraints.Integer]() *TypeView[T] {
+	s := getOrCreateSetForType[T]()
+	s.MarkUsed()
+
+	s.mu.RLock()
+
+	v := &TypeView[T]{
+		byName:     make(map[string]viewEntry[T], len(s.enums)),
+		byID:       make(map[T]viewEntry[T], len(s.enums)),
+		metadata:   make(map[*internalEnum[T]]map[string]string, len(s.enums)),
+		normalizer: s.normalizer,
+	}
+
+	for _, e := range s.enums {
+		r := e
+		for {
+			next, ok := s.replacements[r]
+			if !ok {
+				break
+			}
+
+			r = next
+		}
+
+		value := Enum[T]{internalEnumWrapper[T]{r}}
+
+		if r == e {
+			v.values = append(v.values, value)
+		}
+
+		v.metadata[e] = nil
+		v.byName[s.key(e.name)] = viewEntry[T]{e, value}
+		v.byID[e.id] = viewEntry[T]{e, value}
+	}
+
+	s.mu.RUnlock()
+
+	// Loaders are called without holding the lock as they may look up enums.
+	for e := range v.metadata {
+		v.metadata[e] = Enum[T]{internalEnumWrapper[T]{e}}.Metadata()
+	}
+
+	return v
+}
+
+// Values returns all enums in the view in registration order. Retired enums
+// are not included. The returned slice must not be modified.
+func (v *TypeView[T]) Values() []Enum[T] {
+	return v.values
+}
+
+// Len returns the number of enums returned by Values.
+func (v *TypeView[T]) Len() int {
+	return len(v.values)
+}
+
+// Parse returns the enum with the given name and true, or false if there is
+// none in the view or its lifecycle stage is rejected.
+func (v *TypeView[T]) Parse(name string) (Enum[T], bool) {
+	if v.normalizer != nil {
+		name = v.normalizer(name)
+	}
+
+	return v.lookup(v.byName[name])
+}
+
+// FromID returns the enum with the given ID and true, or false if there is
+// none in the view or its lifecycle stage is rejected.
+func (v *TypeView[T]) FromID(id T) (Enum[T], bool) {
+	return v.lookup(v.byID[id])
+}
+
+// lookup returns the enum of the given entry after applying the lifecycle
+// policy, or false if the entry is empty or the enum is rejected.
+func (v *TypeView[T]) lookup(entry viewEntry[T]) (Enum[T], bool) {
+	if entry.registered == nil || entry.registered.set.CheckLifecycle(entry.registered) != nil {
+		return Enum[T]{}, false
+	}
+
+	return entry.value, true
+}
+
+// MetadataValue returns the metadata value associated with the given key for
+// the given enum and true, or false if there is none or the enum is not in
+// the view.
+func (v *TypeView[T]) MetadataValue(e Member[T], key string) (string, bool) {
+	value, ok := v.metadata[e.wrapper().internalEnum][key]
+
+	return value, ok
+}
@@ -0,0 +1,55 @@
+package enum
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSnapshot(t *testing.T) {
+	if debugMode {
+		t.Skip("late registration is rejected with the enumdebug build tag")
+	}
+
+	type snapshotStatus uint8
+
+	active := New[snapshotStatus]("Active", Metadata("color", "green"))
+	legacy := New[snapshotStatus]("Legacy")
+	Retire(legacy, active)
+	SetNormalizer[snapshotStatus](strings.ToLower)
+
+	v := Snapshot[snapshotStatus]()
+
+	late := New[snapshotStatus]("Late")
+
+	if v.Len() != 1 || v.Values()[0] != active {
+		t.Errorf("unexpected values %v", v.Values())
+	}
+
+	if e, ok := v.Parse("ACTIVE"); !ok || e != active {
+		t.Errorf("expected %v, got %v", active, e)
+	}
+
+	if e, ok := v.Parse("Legacy"); !ok || e != active {
+		t.Errorf("expected retired enum to resolve to %v, got %v", active, e)
+	}
+
+	if e, ok := v.FromID(legacy.ID()); !ok || e != active {
+		t.Errorf("expected retired enum to resolve to %v, got %v", active, e)
+	}
+
+	if _, ok := v.Parse("Late"); ok {
+		t.Errorf("expected enum registered after the snapshot to not be visible")
+	}
+
+	if _, ok := v.FromID(late.ID()); ok {
+		t.Errorf("expected enum registered after the snapshot to not be visible")
+	}
+
+	if value, ok := v.MetadataValue(active, "color"); !ok || value != "green" {
+		t.Errorf("expected green, got %q", value)
+	}
+
+	if _, ok := v.MetadataValue(late, "color"); ok {
+		t.Errorf("expected no metadata, got some")
+	}
+}
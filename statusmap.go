@@ -0,0 +1,128 @@
+package enum
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Status is an HTTP status and a gRPC status code (a codes.Code value).
+type Status struct {
+	HTTP int
+	GRPC uint32
+}
+
+// defaultStatus is used for errors without a code: 500 (Internal Server
+// Error) and 2 (Unknown).
+var defaultStatus = Status{HTTP: http.StatusInternalServerError, GRPC: 2}
+
+// StatusMap declares the HTTP status and gRPC code of every error code of
+// type T (see Code), so API errors are translated consistently at the edge
+// of every service. Unlike the HTTPStatus and GRPCCode options, the mapping
+// is kept apart from the definitions and is checked for completeness:
+//
+//	var statuses = enum.MustNewStatusMap(map[enum.Enum[ErrorCode]]enum.Status{
+//		enum.Enum[ErrorCode](NotFound): {HTTP: 404, GRPC: 5},
+//		enum.Enum[ErrorCode](Conflict): {HTTP: 409, GRPC: 6},
+//	})
+//
+// A StatusMap is immutable and safe for concurrent use.
+type StatusMap[T constraints.Integer] struct {
+	statuses map[Enum[T]]Status
+}
+
+// NewStatusMap returns a new StatusMap with the given statuses. This returns a
+// non-nil error if there is no status for an enum associated with type T (as
+// returned by EnumsByType) or if there are statuses for other enums, so
+// adding a code without a status fails at startup (or in a test).
+func NewStatusMap[T constraints.Integer](statuses map[Enum[T]]Status) (*StatusMap[T], error) {
+	m := &StatusMap[T]{statuses: make(map[Enum[T]]Status, len(statuses))}
+
+	var missing []string
+	for _, e := range EnumsByType[T]() {
+		s, ok := statuses[e]
+		if !ok {
+			missing = append(missing, e.Name())
+			continue
+		}
+
+		m.statuses[e] = s
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing statuses for %s of type %s", strings.Join(missing, ", "), getTypeName[T]())
+	}
+
+	if len(m.statuses) != len(statuses) {
+		return nil, fmt.Errorf("unexpected statuses for retired or unrecognized enums of type %s", getTypeName[T]())
+	}
+
+	return m, nil
+}
+
+// MustNewStatusMap is like NewStatusMap but panics on errors.
+func MustNewStatusMap[T constraints.Integer](statuses map[Enum[T]]Status) *StatusMap[T] {
+	m, err := NewStatusMap(statuses)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return m
+}
+
+// Get returns the status of the given code.
+func (m *StatusMap[T]) Get(code Member[T]) Status {
+	return m.statuses[Enum[T]{code.wrapper()}]
+}
+
+// StatusOf returns the status of the code of err (see CodeOf) or, if there is
+// none, 500 (Internal Server Error) and 2 (Unknown).
+func (m *StatusMap[T]) StatusOf(err error) Status {
+	if c, ok := CodeOf[T](err); ok {
+		if s, ok := m.statuses[Enum[T](c)]; ok {
+			return s
+		}
+	}
+
+	return defaultStatus
+}
+
+// HTTPStatus returns the HTTP status of the code of err (see StatusOf).
+func (m *StatusMap[T]) HTTPStatus(err error) int {
+	return m.StatusOf(err).HTTP
+}
+
+// GRPCCode returns the gRPC code of the code of err (see StatusOf). It is
+// usually applied in an interceptor:
+//
+//	return nil, status.Error(codes.Code(statuses.GRPCCode(err)), err.Error())
+func (m *StatusMap[T]) GRPCCode(err error) uint32 {
+	return m.StatusOf(err).GRPC
+}
+
+// WriteError writes an error response for err with the HTTP status of its
+// code (see StatusOf). The body is the name of the code, or the status text
+// for errors without a code, so internal error messages are not exposed.
+func (m *StatusMap[T]) WriteError(w http.ResponseWriter, err error) {
+	status := m.HTTPStatus(err)
+
+	message := http.StatusText(status)
+	if c, ok := CodeOf[T](err); ok {
+		message = c.Name()
+	}
+
+	http.Error(w, message, status)
+}
+
+// Handler returns an http.Handler calling the given function and, if it
+// returns a non-nil error, writing an error response with WriteError. The
+// function must not write a response when returning an error.
+func (m *StatusMap[T]) Handler(h func(http.ResponseWriter, *http.Request) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := h(w, r); err != nil {
+			m.WriteError(w, err)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package enum
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStatusMap(t *testing.T) {
+	type apiCode int
+
+	notFound := Code[apiCode](New[apiCode]("NotFound"))
+	conflict := Code[apiCode](New[apiCode]("Conflict"))
+
+	if _, err := NewStatusMap(map[Enum[apiCode]]Status{
+		Enum[apiCode](notFound): {HTTP: 404, GRPC: 5},
+	}); err == nil {
+		t.Errorf("expected error for missing statuses, got nil")
+	}
+
+	m := MustNewStatusMap(map[Enum[apiCode]]Status{
+		Enum[apiCode](notFound): {HTTP: 404, GRPC: 5},
+		Enum[apiCode](conflict): {HTTP: 409, GRPC: 6},
+	})
+
+	if s := m.Get(conflict); s != (Status{HTTP: 409, GRPC: 6}) {
+		t.Errorf("unexpected status %+v", s)
+	}
+
+	err := WrapError(errors.New("no such user"), notFound)
+
+	if status := m.HTTPStatus(err); status != 404 {
+		t.Errorf("expected 404, got %d", status)
+	}
+
+	if code := m.GRPCCode(err); code != 5 {
+		t.Errorf("expected 5, got %d", code)
+	}
+
+	if s := m.StatusOf(errors.New("other")); s != (Status{HTTP: 500, GRPC: 2}) {
+		t.Errorf("unexpected status %+v", s)
+	}
+
+	h := m.Handler(func(w http.ResponseWriter, r *http.Request) error {
+		if r.URL.Path == "/ok" {
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		}
+
+		if r.URL.Path == "/internal" {
+			return errors.New("secret details")
+		}
+
+		return err
+	})
+
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{"/ok", http.StatusNoContent, ""},
+		{"/missing", http.StatusNotFound, "NotFound\n"},
+		{"/internal", http.StatusInternalServerError, "Internal Server Error\n"},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, test.path, nil))
+
+		if w.Code != test.status || w.Body.String() != test.body {
+			t.Errorf("%s: expected %d %q, got %d %q", test.path, test.status, test.body, w.Code, w.Body.String())
+		}
+	}
+}
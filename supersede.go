@@ -0,0 +1,68 @@
+package enum
+
+import "golang.org/x/exp/constraints"
+
+// Supersede makes the given successor the value to use in place of the given
+// deprecated value. Unlike with Retire, the deprecated value is still decoded
+// as itself and returned by EnumsByType, so historical data keeps its meaning,
+// and Canonicalize can be used to normalize it to current values:
+//
+//	var (
+//		Trial   = PlanEnum(enum.New[Plan]("Trial", enum.Deprecated()))
+//		Starter = PlanEnum(enum.New[Plan]("Starter"))
+//	)
+//
+//	func init() {
+//		enum.Supersede(Trial, Starter)
+//	}
+//
+// This panics if any of the values is invalid, if the value is not deprecated
+// or was already superseded or if superseding it would create a cycle.
+func Supersede[E EnumType[T], T constraints.Integer](value, successor E) {
+	e := Enum[T](value)
+	s := Enum[T](successor)
+
+	if !e.Valid() || !s.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if err := getOrCreateSetForType[T]().Supersede(e.internalEnum, s.internalEnum); err != nil {
+		panic(err.Error())
+	}
+
+	emit(EventDeprecated, e.internalEnum)
+}
+
+// Successor returns the value that supersedes this Enum (see Supersede) and
+// true, or false if it was not superseded.
+func (e internalEnumWrapper[T]) Successor() (Enum[T], bool) {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if e.set == nil {
+		return Enum[T]{}, false
+	}
+
+	successor, ok := e.set.Successor(e.internalEnum)
+	if !ok {
+		return Enum[T]{}, false
+	}
+
+	return Enum[T]{internalEnumWrapper[T]{successor}}, true
+}
+
+// Canonicalize returns the current value for this Enum, following its chain
+// of successors (see Supersede) and replacements (see Retire). It returns the
+// Enum itself if it was neither superseded nor retired.
+func (e internalEnumWrapper[T]) Canonicalize() Enum[T] {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if e.set == nil {
+		return Enum[T]{e}
+	}
+
+	return Enum[T]{internalEnumWrapper[T]{e.set.Canonicalize(e.internalEnum)}}
+}
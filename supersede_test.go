@@ -0,0 +1,72 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSupersede(t *testing.T) {
+	if debugMode {
+		t.Skip("late registration is rejected with the enumdebug build tag")
+	}
+
+	type plan int
+
+	trial := New[plan]("Trial", Deprecated())
+	basic := New[plan]("Basic", Deprecated())
+	starter := New[plan]("Starter", Deprecated())
+	pro := New[plan]("Pro")
+
+	Supersede(trial, basic)
+	Supersede(basic, starter)
+	Retire(starter, pro)
+
+	var decoded Enum[plan]
+	if err := json.Unmarshal([]byte(`"Trial"`), &decoded); err != nil || decoded != trial {
+		t.Errorf("expected superseded value to be decoded as itself, got %v (%v)", decoded, err)
+	}
+
+	if successor, ok := trial.Successor(); !ok || successor != basic {
+		t.Errorf("expected %s, got %v", basic, successor)
+	}
+
+	if _, ok := pro.Successor(); ok {
+		t.Errorf("expected no successor")
+	}
+
+	for _, e := range []Enum[plan]{trial, basic, starter, pro} {
+		if got := e.Canonicalize(); got != pro {
+			t.Errorf("expected %s for %s, got %s", pro, e, got)
+		}
+	}
+
+	for _, p := range Validate() {
+		if p.Type == getType[plan]() {
+			t.Errorf("unexpected problem %s", p)
+		}
+	}
+
+	expectPanic(t, func() {
+		Supersede(pro, trial)
+	})
+
+	expectPanic(t, func() {
+		Supersede(trial, pro)
+	})
+
+	legacy := New[plan]("Legacy", Deprecated())
+
+	expectPanic(t, func() {
+		Supersede(legacy, legacy)
+	})
+
+	expectPanic(t, func() {
+		Retire(pro, trial)
+	})
+
+	ReplaceDeprecated[plan]()
+
+	if data, err := json.Marshal(trial); err != nil || string(data) != `"Pro"` {
+		t.Errorf("expected \"Pro\", got %s (%v)", data, err)
+	}
+}
@@ -0,0 +1,17 @@
+// Command inline calls the accessors of an enum so TestInlining can check,
+// with the compiler's optimization decisions, that they are inlined.
+package main
+
+import (
+	"fmt"
+
+	"github.com/bruno-ga/enum"
+)
+
+type Color int
+
+var Red = enum.New[Color]("Red")
+
+func main() {
+	fmt.Println(Red.ID(), Red.Name(), Red.String())
+}
@@ -0,0 +1,84 @@
+package enum
+
+import (
+	"reflect"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// TagType associates the given tags with type T as a whole (unlike Tag, which
+// tags individual enums), so types can be discovered with TypesWithTag. It is
+// intended for code operating on selected types only, like documentation or
+// schema generators limited to the types intentionally exposed by an API:
+//
+//	func init() {
+//		enum.TagType[Role]("api-exposed")
+//	}
+//
+//	http.Handle("/enums", enumdoc.Handler(enum.TypesWithTag("api-exposed")...))
+func TagType[T constraints.Integer](tags ...string) {
+	getOrCreateSetForType[T]().AddTypeTags(tags)
+}
+
+// TypeTags returns the tags associated with type T with TagType, in the order
+// they were added.
+func TypeTags[T constraints.Integer]() []string {
+	s, err := getSetForType[T]()
+	if err != nil {
+		return nil
+	}
+
+	return s.TypeTags()
+}
+
+// typeTagger is implemented by all sets.
+type typeTagger interface {
+	TypeTags() []string
+}
+
+// TypesWithTag returns the types associated with the given tag with TagType,
+// sorted by name.
+func TypesWithTag(tag string) []TypeSelector {
+	setByTypeMu.RLock()
+	sets := make(map[reflect.Type]any, len(setByType))
+	for t, s := range setByType {
+		sets[t] = s
+	}
+	setByTypeMu.RUnlock()
+
+	var types []TypeSelector
+
+	for t, s := range sets {
+		if contains(s.(typeTagger).TypeTags(), tag) {
+			types = append(types, TypeSelector{t})
+		}
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].t.String() < types[j].t.String()
+	})
+
+	return types
+}
+
+// AddTypeTags associates the given tags with the set. Tags already
+// associated with it are ignored.
+func (s *internalSet[T]) AddTypeTags(tags []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, tag := range tags {
+		if !contains(s.typeTags, tag) {
+			s.typeTags = append(s.typeTags, tag)
+		}
+	}
+}
+
+// TypeTags returns a copy of the tags associated with the set.
+func (s *internalSet[T]) TypeTags() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]string(nil), s.typeTags...)
+}
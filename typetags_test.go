@@ -0,0 +1,39 @@
+package enum
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTagType(t *testing.T) {
+	type exposed int
+	type internal int
+
+	New[exposed]("Public")
+	New[internal]("Private")
+
+	TagType[exposed]("api-exposed", "stable")
+	TagType[exposed]("api-exposed")
+	TagType[internal]("stable")
+
+	if tags := TypeTags[exposed](); !reflect.DeepEqual(tags, []string{"api-exposed", "stable"}) {
+		t.Errorf("unexpected tags %v", tags)
+	}
+
+	if tags := TypeTags[Permission](); len(tags) != 0 {
+		t.Errorf("expected no tags, got %v", tags)
+	}
+
+	if types := TypesWithTag("api-exposed"); !reflect.DeepEqual(types, []TypeSelector{TypeOf[exposed]()}) {
+		t.Errorf("unexpected types %v", types)
+	}
+
+	types := TypesWithTag("stable")
+	if len(types) != 2 || types[0] != TypeOf[exposed]() || types[1] != TypeOf[internal]() {
+		t.Errorf("unexpected types %v", types)
+	}
+
+	if types := TypesWithTag("unknown"); len(types) != 0 {
+		t.Errorf("expected no types, got %v", types)
+	}
+}
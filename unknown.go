@@ -0,0 +1,60 @@
+package enum
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// RequireUnknownZero enforces the convention that the enum associated with
+// type T with ID 0 (the zero value of T) has the given name, usually
+// "Unknown", and is declared first:
+//
+//	func init() {
+//		enum.RequireUnknownZero[Role]("Unknown")
+//	}
+//
+//	var UnknownRole = enum.New[Role]("Unknown") // 0
+//
+// Registering an enum with that name and a different ID, or another enum with
+// ID 0, then panics. The enum also becomes the default when decoding empty
+// names (as text, JSON, SQL or with MapstructureHook) and NULL SQL values,
+// and the fallback of ParseOrUnknown. Validate reports the type if no enum
+// has ID 0.
+//
+// It panics if already registered enums violate the convention or if it was
+// already called for type T with a different name.
+func RequireUnknownZero[T constraints.Integer](name string) {
+	if name == "" {
+		panic(fmt.Sprintf("unknown enum name cannot be empty (type %s)", getTypeName[T]()))
+	}
+
+	if err := getOrCreateSetForType[T]().RequireUnknownZero(name); err != nil {
+		panic(err.Error())
+	}
+}
+
+// ParseOrUnknown returns the enum associated with type T with the given name
+// or, if there is none, the enum with ID 0 required by RequireUnknownZero.
+// Retired values are resolved to their replacements. It panics if
+// RequireUnknownZero was not called for type T or if the enum is not
+// registered.
+func ParseOrUnknown[T constraints.Integer](name string) Enum[T] {
+	s := getOrCreateSetForType[T]()
+
+	u := s.Unknown()
+	if u == nil {
+		panic("no enum with ID 0 required for type " + getTypeName[T]() + " (see RequireUnknownZero)")
+	}
+
+	s.MarkUsed()
+
+	// Unknown names are not added to open types as unrecognized enums.
+	if e := s.Get(name); e != nil {
+		if r, err := resolveDecoded(s, e); err == nil {
+			return Enum[T]{internalEnumWrapper[T]{r}}
+		}
+	}
+
+	return Enum[T]{internalEnumWrapper[T]{u}}
+}
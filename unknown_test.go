@@ -0,0 +1,105 @@
+package enum
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type unknownZeroStatus int
+
+func init() {
+	RequireUnknownZero[unknownZeroStatus]("Unknown")
+}
+
+var (
+	unknownZeroUnknown = New[unknownZeroStatus]("Unknown")
+	unknownZeroActive  = New[unknownZeroStatus]("Active")
+)
+
+func TestRequireUnknownZero(t *testing.T) {
+	expectPanic(t, func() {
+		NewWithID[unknownZeroStatus]("Zero", 0)
+	})
+
+	expectPanic(t, func() {
+		RequireUnknownZero[unknownZeroStatus]("None")
+	})
+
+	type late int
+
+	New[late]("None")
+
+	expectPanic(t, func() {
+		RequireUnknownZero[late]("Unknown")
+	})
+
+	type reserved int
+
+	RequireUnknownZero[reserved]("Unknown")
+
+	expectPanic(t, func() {
+		NewWithID[reserved]("Unknown", 1)
+	})
+
+	if e := New[reserved]("Unknown"); e.ID() != 0 {
+		t.Errorf("expected ID 0, got %d", e.ID())
+	}
+}
+
+func TestRequireUnknownZero_Default(t *testing.T) {
+	var v struct {
+		Status Enum[unknownZeroStatus] `json:"status"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"status": ""}`), &v); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v.Status != unknownZeroUnknown {
+		t.Errorf("expected %s, got %v", unknownZeroUnknown, v.Status)
+	}
+
+	if err := json.Unmarshal([]byte(`{"status": "Missing"}`), &v); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	var scanned Enum[unknownZeroStatus]
+	if err := scanned.Scan(nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if scanned != unknownZeroUnknown {
+		t.Errorf("expected %s, got %v", unknownZeroUnknown, scanned)
+	}
+
+	hook := MapstructureHook(HookLenient())
+
+	decoded, err := hook(reflect.TypeOf(""), reflect.TypeOf(scanned), " ")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if decoded != unknownZeroUnknown {
+		t.Errorf("expected %s, got %v", unknownZeroUnknown, decoded)
+	}
+
+	var role Enum[Role]
+	if err := role.Scan(nil); err != nil || role.Valid() {
+		t.Errorf("expected NULL to leave the enum untouched, got %v (%v)", role, err)
+	}
+}
+
+func TestParseOrUnknown(t *testing.T) {
+	if e := ParseOrUnknown[unknownZeroStatus]("Active"); e != unknownZeroActive {
+		t.Errorf("expected %s, got %s", unknownZeroActive, e)
+	}
+
+	if e := ParseOrUnknown[unknownZeroStatus]("Missing"); e != unknownZeroUnknown {
+		t.Errorf("expected %s, got %s", unknownZeroUnknown, e)
+	}
+
+	expectPanic(t, func() {
+		ParseOrUnknown[Role]("Missing")
+	})
+}
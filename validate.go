@@ -0,0 +1,178 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// enumValidator is implemented by Enum[T] and by every type derived from it.
+type enumValidator interface {
+	initialized() bool
+	checkRegistered() error
+	validate() error
+}
+
+func (e internalEnumWrapper[T]) initialized() bool {
+	return e.internalEnum != nil
+}
+
+// checkRegistered returns a non-nil error if the Enum is not valid or is not
+// registered.
+func (e internalEnumWrapper[T]) checkRegistered() error {
+	if !e.Valid() {
+		return fmt.Errorf("enum not initialized")
+	}
+
+	registered, err := getInternalEnumForName[T](e.name)
+	if err != nil {
+		return err
+	}
+
+	if registered.id != e.id {
+		return fmt.Errorf("enum %s has id %d but registered one has id %d", e.name, e.id, registered.id)
+	}
+
+	return nil
+}
+
+// validate returns a non-nil error if the Enum is not valid, is not registered,
+// is retired or is deprecated.
+func (e internalEnumWrapper[T]) validate() error {
+	if err := e.checkRegistered(); err != nil {
+		return err
+	}
+
+	if e.Retired() {
+		return fmt.Errorf("enum %s is retired", e.name)
+	}
+
+	if e.Deprecated() {
+		return fmt.Errorf("enum %s is deprecated", e.name)
+	}
+
+	return nil
+}
+
+// MustBeRegistered panics if any of the given values is not an Enum (or a type
+// derived from one) holding a registered value. It is intended to be called
+// from init functions of packages that reference enums declared in other
+// packages so "used before registered" bugs caused by initialization order
+// are caught at startup:
+//
+//	func init() {
+//		enum.MustBeRegistered(accounts.Admin, accounts.User)
+//	}
+func MustBeRegistered(values ...any) {
+	var problems []string
+
+	for i, v := range values {
+		if v == nil || !isEnumType(reflect.TypeOf(v)) {
+			problems = append(problems, fmt.Sprintf("value %d (%T) is not an enum", i, v))
+			continue
+		}
+
+		ev := v.(enumValidator)
+
+		err := ev.checkRegistered()
+		if err == nil {
+			continue
+		}
+
+		if ev.initialized() {
+			problems = append(problems, fmt.Sprintf("value %d (%T): %s", i, v, err))
+			continue
+		}
+
+		problems = append(problems, fmt.Sprintf(
+			"value %d (%T): %s (it was probably used before being registered, check package initialization order)", i, v, err))
+	}
+
+	if len(problems) > 0 {
+		panic("enum.MustBeRegistered: " + strings.Join(problems, "; "))
+	}
+}
+
+// ValidateStruct recursively checks all Enum-typed fields in the given struct
+// (or pointer to struct) and returns a FieldErrors with one entry for every
+// field holding an Enum that is not initialized, is not registered, is retired
+// or is deprecated. Nested structs, pointers, interfaces, slices, arrays and map
+// values are all inspected. Unexported fields are ignored.
+//
+// Paths in the returned errors use Go syntax relative to the given value (for
+// example, "Members[1].Role").
+func ValidateStruct(v any) error {
+	w := structWalker{
+		visited: make(map[uintptr]bool),
+	}
+
+	w.walk(reflect.ValueOf(v), "")
+
+	if len(w.errs) > 0 {
+		return w.errs
+	}
+
+	return nil
+}
+
+type structWalker struct {
+	errs    FieldErrors
+	visited map[uintptr]bool
+}
+
+func (w *structWalker) walk(v reflect.Value, path string) {
+	if !v.IsValid() {
+		return
+	}
+
+	if isEnumType(v.Type()) {
+		if err := v.Interface().(enumValidator).validate(); err != nil {
+			w.errs = append(w.errs, &FieldError{path, err})
+		}
+
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() || w.visited[v.Pointer()] {
+			return
+		}
+
+		w.visited[v.Pointer()] = true
+
+		w.walk(v.Elem(), path)
+	case reflect.Interface:
+		if !v.IsNil() {
+			w.walk(v.Elem(), path)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+
+			w.walk(v.Field(i), joinPath(path, f.Name))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			w.walk(v.Index(i), path+"["+strconv.Itoa(i)+"]")
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			w.walk(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key()))
+		}
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -0,0 +1,105 @@
+package enum
+
+import (
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+type validateStatus int
+
+var (
+	validateActive = New[validateStatus]("Active")
+	validateLegacy = New[validateStatus]("Legacy", Deprecated())
+)
+
+type validateInner struct {
+	Status Enum[validateStatus]
+}
+
+type validateOuter struct {
+	Role     RoleEnum
+	Inner    validateInner
+	Pointer  *validateInner
+	Children []validateInner
+	ByName   map[string]Enum[validateStatus]
+	Any      any
+	Self     *validateOuter
+
+	unexported RoleEnum
+}
+
+func TestDeprecated(t *testing.T) {
+	if validateActive.Deprecated() {
+		t.Errorf("expected %s to not be deprecated", validateActive)
+	}
+	if !validateLegacy.Deprecated() {
+		t.Errorf("expected %s to be deprecated", validateLegacy)
+	}
+}
+
+func TestValidateStruct(t *testing.T) {
+	valid := &validateOuter{
+		Role:     Admin,
+		Inner:    validateInner{validateActive},
+		Pointer:  &validateInner{validateActive},
+		Children: []validateInner{{validateActive}},
+		ByName:   map[string]Enum[validateStatus]{"a": validateActive},
+		Any:      validateInner{validateActive},
+	}
+	valid.Self = valid
+
+	if err := ValidateStruct(valid); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	invalid := validateOuter{
+		Inner:    validateInner{validateLegacy},
+		Children: []validateInner{{validateActive}, {}},
+		ByName: map[string]Enum[validateStatus]{"a": {internalEnumWrapper[validateStatus]{&internalEnum[validateStatus]{
+			name: "Removed",
+			id:   42,
+		}}}},
+	}
+
+	err := ValidateStruct(invalid)
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	paths := fieldErrorPaths(t, err)
+	if len(paths) != 4 {
+		t.Fatalf("expected 4 errors, got %v", paths)
+	}
+	for _, path := range []string{"Role", "Inner.Status", "Children[1].Status", "ByName[a]"} {
+		if !slices.Contains(paths, path) {
+			t.Errorf("expected error for %s, got %v", path, paths)
+		}
+	}
+}
+
+func TestMustBeRegistered(t *testing.T) {
+	MustBeRegistered(Admin, Enum[Permission](Read), validateLegacy)
+
+	tests := []struct {
+		name   string
+		values []any
+	}{
+		{"zero value", []any{Admin, RoleEnum{}}},
+		{"not an enum", []any{Admin, 1}},
+		{"nil", []any{nil}},
+		{"not registered", []any{RoleEnum{internalEnumWrapper[Role]{&internalEnum[Role]{name: "Root", id: 7}}}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected panic, got normal execution")
+				}
+			}()
+
+			MustBeRegistered(test.values...)
+		})
+	}
+}
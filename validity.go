@@ -0,0 +1,72 @@
+package enum
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ValidFrom makes the Enum active starting at the given time (inclusive), for
+// values that legally come into effect on a given date (for example, tax codes
+// or plan tiers). By default, enums are active since forever.
+func ValidFrom(t time.Time) Option {
+	return func(a *attributes) {
+		a.validFrom = t
+	}
+}
+
+// ValidUntil makes the Enum inactive starting at the given time (exclusive).
+// By default, enums are active forever.
+func ValidUntil(t time.Time) Option {
+	return func(a *attributes) {
+		a.validUntil = t
+	}
+}
+
+// Validity returns the bounds of the period this Enum is active in (see
+// ValidFrom and ValidUntil). Zero times mean unbounded.
+func (e internalEnumWrapper[T]) Validity() (from, until time.Time) {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	return e.internalEnum.validFrom, e.internalEnum.validUntil
+}
+
+// ActiveAt returns true if this Enum is active at the given time.
+func (e internalEnumWrapper[T]) ActiveAt(t time.Time) bool {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	from, until := e.internalEnum.validFrom, e.internalEnum.validUntil
+
+	return (from.IsZero() || !t.Before(from)) && (until.IsZero() || t.Before(until))
+}
+
+// ActiveAt returns a predicate for Where that matches enums active at the
+// given time:
+//
+//	current := enum.Where(enum.ActiveAt[TaxCode](time.Now()))
+func ActiveAt[T constraints.Integer](t time.Time) func(Enum[T]) bool {
+	return func(e Enum[T]) bool {
+		return e.ActiveAt(t)
+	}
+}
+
+// ParseActiveAt is like EnumByTypeAndName but also returns a non-nil error if
+// the enum is not active at the given time (for example, the date of the
+// transaction being processed).
+func ParseActiveAt[T constraints.Integer](name string, t time.Time) (Enum[T], error) {
+	e, err := EnumByTypeAndName[T](name)
+	if err != nil {
+		return Enum[T]{}, err
+	}
+
+	if !e.ActiveAt(t) {
+		return Enum[T]{}, fmt.Errorf("enum %s of type %s is not active at %s", e.Name(), getTypeName[T](), t.Format(time.RFC3339))
+	}
+
+	return e, nil
+}
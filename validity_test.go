@@ -0,0 +1,62 @@
+package enum
+
+import (
+	"testing"
+	"time"
+)
+
+func TestActiveAt(t *testing.T) {
+	type taxCode int
+
+	cutover := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	old := New[taxCode]("Old", ValidUntil(cutover))
+	current := New[taxCode]("Current", ValidFrom(cutover))
+	always := New[taxCode]("Always")
+
+	before := cutover.Add(-time.Second)
+
+	if !old.ActiveAt(before) || old.ActiveAt(cutover) {
+		t.Errorf("expected %s to be active until %s (exclusive)", old, cutover)
+	}
+
+	if current.ActiveAt(before) || !current.ActiveAt(cutover) {
+		t.Errorf("expected %s to be active from %s (inclusive)", current, cutover)
+	}
+
+	if from, until := old.Validity(); !from.IsZero() || !until.Equal(cutover) {
+		t.Errorf("unexpected validity %s - %s", from, until)
+	}
+
+	active := Where(ActiveAt[taxCode](cutover))
+	if len(active) != 2 || active[0] != current || active[1] != always {
+		t.Errorf("unexpected active enums %v", active)
+	}
+
+	if _, err := ParseActiveAt[taxCode]("Old", before); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := ParseActiveAt[taxCode]("Old", cutover); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if _, err := ParseActiveAt[taxCode]("Missing", cutover); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestLoadDefinitions_Validity(t *testing.T) {
+	type plan int
+
+	enums, err := LoadDefinitions[plan]([]byte("- name: Legacy\n  valid_until: 2025-01-01\n- name: Pro\n  valid_from: 2025-01-01T00:00:00Z\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cutover := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if enums[0].ActiveAt(cutover) || !enums[1].ActiveAt(cutover) {
+		t.Errorf("unexpected validity")
+	}
+}
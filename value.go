@@ -0,0 +1,114 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Value is implemented by all Enum[T] (and types derived from them), for
+// code that handles enums of any type uniformly (for example, audit logging
+// or rule evaluation).
+type Value interface {
+	// Name returns the name of the enum.
+	Name() string
+
+	// Int64ID returns the ID of the enum converted to an int64. IDs of
+	// unsigned 64-bit types above math.MaxInt64 wrap around.
+	Int64ID() int64
+
+	// TypeName returns the name of the type T associated with the enum,
+	// without its package.
+	TypeName() string
+
+	// QualifiedName returns the name of the enum qualified by TypeName.
+	QualifiedName() string
+}
+
+// Int64ID returns the ID of this Enum converted to an int64.
+func (e internalEnumWrapper[T]) Int64ID() int64 {
+	return int64(e.ID())
+}
+
+// TypeName returns the name of the type T associated with this Enum, without
+// its package.
+func (e internalEnumWrapper[T]) TypeName() string {
+	return getType[T]().Name()
+}
+
+// AsValue returns v as a Value and true if it is a valid Enum[T] (or a type
+// derived from it), or a pointer to one. Otherwise, it returns false.
+func AsValue(v any) (Value, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, false
+		}
+
+		rv = rv.Elem()
+	}
+
+	if !rv.IsValid() || !isEnumType(rv.Type()) {
+		return nil, false
+	}
+
+	if !rv.Interface().(enumValidator).initialized() {
+		return nil, false
+	}
+
+	return rv.Interface().(Value), true
+}
+
+// valueLookup is implemented by all sets.
+type valueLookup interface {
+	lookupValue(name string) (Value, error)
+}
+
+func (s *internalSet[T]) lookupValue(name string) (Value, error) {
+	e, err := parseInternalEnum[T](name)
+	if err != nil {
+		return nil, err
+	}
+
+	return Enum[T]{internalEnumWrapper[T]{e}}, nil
+}
+
+// FromQualified returns the enum with the given qualified name (as returned
+// by QualifiedName) as a Value. This returns a non-nil error if no type with
+// the qualifying name has enums, if more than one does (as type names are
+// only unique within their package) or if the type has no enum with the given
+// name.
+func FromQualified(qualified string) (Value, error) {
+	typeName, name, ok := strings.Cut(qualified, ".")
+	if !ok {
+		return nil, fmt.Errorf("name %s is not qualified", qualified)
+	}
+
+	setByTypeMu.RLock()
+	var matches []reflect.Type
+	var s any
+	for t, ts := range setByType {
+		if t.Name() == typeName {
+			matches = append(matches, t)
+			s = ts
+		}
+	}
+	setByTypeMu.RUnlock()
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no enum set associated with type %s", typeName)
+	case 1:
+		return s.(valueLookup).lookupValue(name)
+	}
+
+	types := make([]string, 0, len(matches))
+	for _, t := range matches {
+		types = append(types, t.PkgPath()+"."+t.Name())
+	}
+
+	sort.Strings(types)
+
+	return nil, fmt.Errorf("type name %s is ambiguous (%s)", typeName, strings.Join(types, ", "))
+}
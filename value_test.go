@@ -0,0 +1,75 @@
+package enum
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAsValue(t *testing.T) {
+	admin := Admin
+
+	for _, v := range []any{Admin, &admin, Enum[Permission](Read)} {
+		value, ok := AsValue(v)
+		if !ok {
+			t.Fatalf("expected %v to be a Value", v)
+		}
+
+		if value.Name() != "Admin" && value.Name() != "Read" {
+			t.Errorf("unexpected name %s", value.Name())
+		}
+	}
+
+	value, _ := AsValue(Admin)
+	if value.Int64ID() != 1 || value.TypeName() != "Role" || value.QualifiedName() != "Role.Admin" {
+		t.Errorf("unexpected value %s (%d)", value.QualifiedName(), value.Int64ID())
+	}
+
+	var nilRole *RoleEnum
+
+	for _, v := range []any{nil, 1, "Admin", RoleEnum{}, nilRole, struct{ RoleEnum }{Admin}} {
+		if _, ok := AsValue(v); ok {
+			t.Errorf("expected %#v not to be a Value", v)
+		}
+	}
+
+	type huge uint64
+
+	if id := NewWithID[huge]("Max", math.MaxUint64).Int64ID(); id != -1 {
+		t.Errorf("expected -1, got %d", id)
+	}
+}
+
+func TestFromQualified(t *testing.T) {
+	value, err := FromQualified("Role.Guest")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if value != Value(Enum[Role](Guest)) {
+		t.Errorf("expected %s, got %s", Guest, value.QualifiedName())
+	}
+
+	for _, qualified := range []string{"Guest", "Missing.Guest", "Role.Missing"} {
+		if _, err := FromQualified(qualified); err == nil {
+			t.Errorf("expected error for %s, got nil", qualified)
+		}
+	}
+}
+
+func TestFromQualified_Ambiguous(t *testing.T) {
+	func() {
+		type ambiguous int
+
+		New[ambiguous]("A")
+	}()
+
+	func() {
+		type ambiguous int
+
+		New[ambiguous]("A")
+	}()
+
+	if _, err := FromQualified("ambiguous.A"); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
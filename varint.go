@@ -0,0 +1,119 @@
+package enum
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+
+	"golang.org/x/exp/constraints"
+)
+
+// BinaryFormat selects the compact binary encoding used by AppendBinary and
+// DecodeBinary.
+type BinaryFormat int
+
+const (
+	// BinaryID encodes the ID as a varint (zig-zag encoded for signed types,
+	// as by binary.PutVarint, and unsigned otherwise).
+	BinaryID BinaryFormat = iota
+
+	// BinaryTypedID prefixes the varint ID with a 4-byte (big-endian) FNV-1a
+	// hash of the name of type T, without its package, so values decoded as
+	// the wrong type are detected.
+	BinaryTypedID
+)
+
+// AppendBinary appends the compact binary encoding of the given value to dst
+// and returns the extended buffer. Unlike MarshalBinary, the encoding uses IDs
+// so it is only suitable when IDs are stable (see NewWithID and HashID).
+func AppendBinary[E EnumType[T], T constraints.Integer](dst []byte, value E, format BinaryFormat) ([]byte, error) {
+	e := Enum[T](value)
+	if !e.Valid() {
+		return dst, fmt.Errorf("enum not initialized")
+	}
+
+	m, err := e.marshaled()
+	if err != nil {
+		return dst, err
+	}
+
+	var buf [4 + binary.MaxVarintLen64]byte
+
+	n := 0
+	if format == BinaryTypedID {
+		binary.BigEndian.PutUint32(buf[:], typeHash[T]())
+		n = 4
+	}
+
+	if isSigned[T]() {
+		n += binary.PutVarint(buf[n:], int64(m.id))
+	} else {
+		n += binary.PutUvarint(buf[n:], uint64(m.id))
+	}
+
+	return append(dst, buf[:n]...), nil
+}
+
+// DecodeBinary decodes a value encoded with AppendBinary with the same format
+// from the start of data and returns it with the number of bytes read.
+// Retired values are resolved to their replacements.
+func DecodeBinary[T constraints.Integer](data []byte, format BinaryFormat) (Enum[T], int, error) {
+	n := 0
+
+	if format == BinaryTypedID {
+		if len(data) < 4 {
+			return Enum[T]{}, 0, fmt.Errorf("data too short for type hash")
+		}
+
+		if h := binary.BigEndian.Uint32(data); h != typeHash[T]() {
+			return Enum[T]{}, 0, fmt.Errorf("type hash %08x does not match type %s", h, getTypeName[T]())
+		}
+
+		n = 4
+	}
+
+	var id T
+	var size int
+
+	if isSigned[T]() {
+		var v int64
+		v, size = binary.Varint(data[n:])
+		id = T(v)
+
+		if size > 0 && int64(id) != v {
+			return Enum[T]{}, 0, fmt.Errorf("id %d out of range for type %s", v, getTypeName[T]())
+		}
+	} else {
+		var v uint64
+		v, size = binary.Uvarint(data[n:])
+		id = T(v)
+
+		if size > 0 && uint64(id) != v {
+			return Enum[T]{}, 0, fmt.Errorf("id %d out of range for type %s", v, getTypeName[T]())
+		}
+	}
+
+	if size <= 0 {
+		return Enum[T]{}, 0, fmt.Errorf("invalid varint")
+	}
+
+	e, err := FromRaw(id)
+	if err != nil {
+		return Enum[T]{}, 0, err
+	}
+
+	return e, n + size, nil
+}
+
+func isSigned[T constraints.Integer]() bool {
+	var zero T
+
+	return zero-1 < zero
+}
+
+func typeHash[T constraints.Integer]() uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(getType[T]().Name()))
+
+	return h.Sum32()
+}
@@ -0,0 +1,82 @@
+package enum
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAppendBinary(t *testing.T) {
+	type signed int16
+	type unsigned uint64
+
+	minusOne := NewWithID[signed]("MinusOne", -1)
+	big := NewWithID[unsigned]("Big", 300)
+
+	tests := []struct {
+		name     string
+		append   func([]byte, BinaryFormat) ([]byte, error)
+		decode   func([]byte, BinaryFormat) (Value, int, error)
+		expected []byte
+	}{
+		{
+			"signed",
+			func(dst []byte, f BinaryFormat) ([]byte, error) { return AppendBinary(dst, minusOne, f) },
+			func(data []byte, f BinaryFormat) (Value, int, error) { return DecodeBinary[signed](data, f) },
+			[]byte{0x01},
+		},
+		{
+			"unsigned",
+			func(dst []byte, f BinaryFormat) ([]byte, error) { return AppendBinary(dst, big, f) },
+			func(data []byte, f BinaryFormat) (Value, int, error) { return DecodeBinary[unsigned](data, f) },
+			[]byte{0xac, 0x02},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := test.append([]byte{0xff}, BinaryID)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !bytes.Equal(data[1:], test.expected) {
+				t.Errorf("expected %x, got %x", test.expected, data[1:])
+			}
+
+			for _, format := range []BinaryFormat{BinaryID, BinaryTypedID} {
+				data, err := test.append(nil, format)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				v, n, err := test.decode(append(data, 0x00), format)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if n != len(data) {
+					t.Errorf("expected %d bytes read, got %d", len(data), n)
+				}
+
+				if v.Name() != "MinusOne" && v.Name() != "Big" {
+					t.Errorf("unexpected value %s", v.Name())
+				}
+			}
+		})
+	}
+
+	typed, _ := AppendBinary(nil, minusOne, BinaryTypedID)
+	if _, _, err := DecodeBinary[unsigned](typed, BinaryTypedID); err == nil {
+		t.Errorf("expected type mismatch error, got nil")
+	}
+
+	for _, data := range [][]byte{nil, {0x80}, {0x02}, {0xff, 0xff, 0xff, 0x7f}} {
+		if _, _, err := DecodeBinary[signed](data, BinaryID); err == nil {
+			t.Errorf("expected error for %x, got nil", data)
+		}
+	}
+
+	if _, err := AppendBinary(nil, Enum[signed]{}, BinaryID); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
@@ -0,0 +1,65 @@
+package enum
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// SetVisibility sets the function deciding whether enums associated with type
+// T are visible in a given context, for multi-tenant products that hide some
+// values (for example, enterprise-only plans) from tenants that should not see
+// or submit them. The function usually inspects a tenant stored in the
+// context:
+//
+//	enum.SetVisibility(func(ctx context.Context, p enum.Enum[Plan]) bool {
+//		return !p.InGroup("enterprise") || tenantFrom(ctx).Enterprise
+//	})
+//
+// It is consulted by VisibleEnums, ParseVisible and Visible. Other functions
+// (including decoding) are not affected. A nil function makes all enums
+// visible (the default).
+func SetVisibility[T constraints.Integer](visible func(ctx context.Context, value Enum[T]) bool) {
+	getOrCreateSetForType[T]().SetVisibility(visible)
+}
+
+// Visible returns true if this Enum is visible in the given context (see
+// SetVisibility).
+func (e internalEnumWrapper[T]) Visible(ctx context.Context) bool {
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	if e.set == nil {
+		return true
+	}
+
+	visible := e.set.Visibility()
+
+	return visible == nil || visible(ctx, Enum[T]{e})
+}
+
+// VisibleEnums is like EnumsByType but only returns the enums visible in the
+// given context (see SetVisibility).
+func VisibleEnums[T constraints.Integer](ctx context.Context) []Enum[T] {
+	return Where(func(e Enum[T]) bool {
+		return e.Visible(ctx)
+	})
+}
+
+// ParseVisible is like EnumByTypeAndName but also returns a non-nil error if
+// the enum is not visible in the given context (see SetVisibility). The error
+// is the same as for unknown names, so hidden values are not disclosed.
+func ParseVisible[T constraints.Integer](ctx context.Context, name string) (Enum[T], error) {
+	e, err := EnumByTypeAndName[T](name)
+	if err != nil {
+		return Enum[T]{}, err
+	}
+
+	if !e.Visible(ctx) {
+		return Enum[T]{}, fmt.Errorf("name %s could not be found in enum set for type %s", name, getTypeName[T]())
+	}
+
+	return e, nil
+}
@@ -0,0 +1,52 @@
+package enum
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSetVisibility(t *testing.T) {
+	type plan int
+
+	type enterpriseKey struct{}
+
+	free := New[plan]("Free")
+	enterprise := New[plan]("Enterprise", Group("enterprise"))
+
+	ctx := context.Background()
+	enterpriseCtx := context.WithValue(ctx, enterpriseKey{}, true)
+
+	if !enterprise.Visible(ctx) {
+		t.Errorf("expected all enums to be visible by default")
+	}
+
+	SetVisibility(func(ctx context.Context, p Enum[plan]) bool {
+		return !p.InGroup("enterprise") || ctx.Value(enterpriseKey{}) == true
+	})
+
+	if visible := VisibleEnums[plan](ctx); len(visible) != 1 || visible[0] != free {
+		t.Errorf("unexpected visible enums %v", visible)
+	}
+
+	if visible := VisibleEnums[plan](enterpriseCtx); len(visible) != 2 {
+		t.Errorf("unexpected visible enums %v", visible)
+	}
+
+	if _, err := ParseVisible[plan](ctx, "Enterprise"); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if e, err := ParseVisible[plan](enterpriseCtx, "Enterprise"); err != nil || e != enterprise {
+		t.Errorf("expected %s, got %v (%v)", enterprise, e, err)
+	}
+
+	if e, err := EnumByTypeAndName[plan]("Enterprise"); err != nil || e != enterprise {
+		t.Errorf("expected lookups to not be affected, got %v (%v)", e, err)
+	}
+
+	SetVisibility[plan](nil)
+
+	if !enterprise.Visible(ctx) {
+		t.Errorf("expected all enums to be visible")
+	}
+}
@@ -0,0 +1,134 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// EventKind is the kind of a change to the registered enums reported to the
+// functions passed to Watch.
+type EventKind int
+
+const (
+	// EventRegistered is emitted when an enum is registered (for example,
+	// with New or Resolve). Unrecognized enums of open types are not
+	// reported.
+	EventRegistered EventKind = iota + 1
+
+	// EventFrozen is emitted when a type is frozen with Freeze.
+	EventFrozen
+
+	// EventDeprecated is emitted when an enum is retired with Retire or
+	// superseded with Supersede.
+	EventDeprecated
+
+	// EventMetadataUpdated is emitted when the metadata of an enum is updated
+	// with UpdateMetadata.
+	EventMetadataUpdated
+)
+
+// String implements the fmt.Stringer interface.
+func (k EventKind) String() string {
+	switch k {
+	case EventRegistered:
+		return "registered"
+	case EventFrozen:
+		return "frozen"
+	case EventDeprecated:
+		return "deprecated"
+	case EventMetadataUpdated:
+		return "metadata updated"
+	default:
+		return fmt.Sprintf("EventKind(%d)", int(k))
+	}
+}
+
+// Event describes a change to the registered enums.
+type Event struct {
+	Kind EventKind
+
+	// Type is the type T associated with the enums.
+	Type reflect.Type
+
+	// Value is the enum the event refers to, or nil for events that refer to
+	// the whole type (EventFrozen).
+	Value Value
+}
+
+// String implements the fmt.Stringer interface.
+func (e Event) String() string {
+	if e.Value == nil {
+		return fmt.Sprintf("%s %s", e.Type, e.Kind)
+	}
+
+	return fmt.Sprintf("%s.%s %s", e.Type, e.Value.Name(), e.Kind)
+}
+
+type watcher struct {
+	id int
+	f  func(Event)
+}
+
+var (
+	watchersMu    sync.RWMutex
+	watchers      []watcher
+	nextWatcherID int
+)
+
+// Watch makes f be called for every change to the registered enums of any
+// type from now on, so caches derived from them (like generated API
+// documentation or frontend bundles) can be invalidated, for example when
+// plugins register enums in development. f is called synchronously by the
+// goroutine making the change, after it is made, so it must not block; it
+// may use this package (for example, to describe the changed type). The
+// returned function stops the calls.
+func Watch(f func(Event)) (stop func()) {
+	watchersMu.Lock()
+	defer watchersMu.Unlock()
+
+	nextWatcherID++
+	id := nextWatcherID
+
+	watchers = append(watchers, watcher{id, f})
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			watchersMu.Lock()
+			defer watchersMu.Unlock()
+
+			for i, w := range watchers {
+				if w.id == id {
+					watchers = append(watchers[:i:i], watchers[i+1:]...)
+					break
+				}
+			}
+		})
+	}
+}
+
+// emit calls the functions passed to Watch with an event of the given kind
+// for type T and the given enum (nil for events about the whole type). It
+// must not be called with the lock of a set held.
+func emit[T constraints.Integer](kind EventKind, e *internalEnum[T]) {
+	watchersMu.RLock()
+	current := watchers
+	watchersMu.RUnlock()
+
+	if len(current) == 0 {
+		return
+	}
+
+	event := Event{Kind: kind, Type: getType[T]()}
+	if e != nil {
+		event.Value = Enum[T]{internalEnumWrapper[T]{e}}
+	}
+
+	for _, w := range current {
+		w.f(event)
+	}
+}
@@ -0,0 +1,59 @@
+package enum
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWatch(t *testing.T) {
+	type flavor int
+
+	var events []string
+
+	stop := Watch(func(e Event) {
+		if e.Type == reflect.TypeOf(flavor(0)) {
+			events = append(events, e.String())
+
+			// Watchers can use the package.
+			_ = EnumsByType[flavor]()
+		}
+	})
+
+	vanilla := New[flavor]("Vanilla", Deprecated())
+	chocolate := New[flavor]("Chocolate")
+	Open[flavor]()
+
+	if _, err := EnumByTypeAndName[flavor]("Mint"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	Supersede(vanilla, chocolate)
+	Freeze[flavor]()
+
+	if err := UpdateMetadata(chocolate, Metadata("label", "Chocolate chip")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	stop()
+	stop()
+
+	if err := UpdateMetadata(chocolate, Metadata("label", "Chocolate")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"enum.flavor.Vanilla registered",
+		"enum.flavor.Chocolate registered",
+		"enum.flavor.Vanilla deprecated",
+		"enum.flavor frozen",
+		"enum.flavor.Chocolate metadata updated",
+	}
+
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("expected %q, got %q", expected, events)
+	}
+
+	if got := EventKind(0).String(); got != "EventKind(0)" {
+		t.Errorf("unexpected name %s", got)
+	}
+}
@@ -0,0 +1,47 @@
+package enum
+
+import "golang.org/x/exp/constraints"
+
+// Where returns all enums associated with type T (as returned by EnumsByType)
+// for which all the given predicates return true.
+//
+//	visible := enum.Where(enum.NotDeprecated[Role], enum.InGroup[Role]("staff"))
+func Where[T constraints.Integer](preds ...func(Enum[T]) bool) []Enum[T] {
+	all := EnumsByType[T]()
+
+	enums := all[:0]
+
+outer:
+	for _, e := range all {
+		for _, pred := range preds {
+			if !pred(e) {
+				continue outer
+			}
+		}
+
+		enums = append(enums, e)
+	}
+
+	return enums
+}
+
+// NotDeprecated is a predicate for Where that matches enums that are not
+// deprecated.
+func NotDeprecated[T constraints.Integer](e Enum[T]) bool {
+	return !e.Deprecated()
+}
+
+// InGroup returns a predicate for Where that matches enums in the given
+// group.
+func InGroup[T constraints.Integer](group string) func(Enum[T]) bool {
+	return func(e Enum[T]) bool {
+		return e.InGroup(group)
+	}
+}
+
+// HasTag returns a predicate for Where that matches enums with the given tag.
+func HasTag[T constraints.Integer](tag string) func(Enum[T]) bool {
+	return func(e Enum[T]) bool {
+		return e.HasTag(tag)
+	}
+}
@@ -0,0 +1,62 @@
+package enum
+
+import "testing"
+
+type whereRole int
+
+var (
+	whereOwner  = New[whereRole]("Owner", Group("staff"), Tag("billing"))
+	whereEditor = New[whereRole]("Editor", Group("staff"))
+	whereLegacy = New[whereRole]("Moderator", Group("staff"), Deprecated())
+	whereViewer = New[whereRole]("Viewer", Tag("billing", "readonly"))
+)
+
+func TestWhere(t *testing.T) {
+	tests := []struct {
+		name     string
+		preds    []func(Enum[whereRole]) bool
+		expected []Enum[whereRole]
+	}{
+		{"all", nil, []Enum[whereRole]{whereOwner, whereEditor, whereLegacy, whereViewer}},
+		{"not deprecated", []func(Enum[whereRole]) bool{NotDeprecated[whereRole]}, []Enum[whereRole]{whereOwner, whereEditor, whereViewer}},
+		{"group", []func(Enum[whereRole]) bool{InGroup[whereRole]("staff")}, []Enum[whereRole]{whereOwner, whereEditor, whereLegacy}},
+		{"tag", []func(Enum[whereRole]) bool{HasTag[whereRole]("billing")}, []Enum[whereRole]{whereOwner, whereViewer}},
+		{"combined", []func(Enum[whereRole]) bool{NotDeprecated[whereRole], InGroup[whereRole]("staff")}, []Enum[whereRole]{whereOwner, whereEditor}},
+		{"none", []func(Enum[whereRole]) bool{HasTag[whereRole]("missing")}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Where(test.preds...)
+			if len(got) != len(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+
+			for i := range got {
+				if got[i] != test.expected[i] {
+					t.Errorf("expected %s at index %d, got %s", test.expected[i], i, got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGroupsAndTags(t *testing.T) {
+	if !whereOwner.InGroup("staff") || whereViewer.InGroup("staff") {
+		t.Errorf("unexpected group membership")
+	}
+	if !whereViewer.HasTag("readonly") || whereOwner.HasTag("readonly") {
+		t.Errorf("unexpected tags")
+	}
+
+	// Returned slices are copies.
+	tags := whereViewer.Tags()
+	tags[0] = "changed"
+	if !whereViewer.HasTag("billing") {
+		t.Errorf("expected tags to be unaffected by changes to returned slice")
+	}
+
+	if groups := whereOwner.Groups(); len(groups) != 1 || groups[0] != "staff" {
+		t.Errorf("expected [staff], got %v", groups)
+	}
+}
@@ -0,0 +1,64 @@
+package enum
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ToWire converts the given value to its ID as wire type W, as used by enum
+// fields of generated FlatBuffers or Cap'n Proto code (usually int8 or uint16
+// based types). It requires the IDs of the enums to be the same as the wire
+// values, which is usually achieved by declaring the enums with NewWithID or
+// registering the generated constants with Wrap. This returns a non-nil error
+// if the value is not valid or its ID does not fit into type W.
+func ToWire[W constraints.Integer, E EnumType[T], T constraints.Integer](value E) (W, error) {
+	e := Enum[T](value)
+	if !e.Valid() {
+		return 0, fmt.Errorf("enum not initialized")
+	}
+
+	m, err := e.marshaled()
+	if err != nil {
+		return 0, err
+	}
+
+	w, ok := convertInteger[W](m.id)
+	if !ok {
+		return 0, fmt.Errorf("id %d of enum %s out of range for wire type %T", m.id, m.name, w)
+	}
+
+	return w, nil
+}
+
+// FromWire returns the Enum associated with type T whose ID is the given wire
+// value (see ToWire). Retired values are resolved to their replacements. This
+// returns a non-nil error if there is no such Enum (for example, for values
+// added to the schema by a newer writer).
+func FromWire[T constraints.Integer, W constraints.Integer](w W) (Enum[T], error) {
+	id, ok := convertInteger[T](w)
+	if !ok {
+		return Enum[T]{}, fmt.Errorf("wire value %d out of range for type %s", w, getTypeName[T]())
+	}
+
+	return FromRaw(id)
+}
+
+// FromWireOr is like FromWire but returns the given fallback (usually an
+// unknown value) instead of failing for unknown wire values.
+func FromWireOr[T constraints.Integer, W constraints.Integer](w W, fallback Enum[T]) Enum[T] {
+	e, err := FromWire[T](w)
+	if err != nil {
+		return fallback
+	}
+
+	return e
+}
+
+// convertInteger converts v to type To and returns true if the value is
+// preserved.
+func convertInteger[To, From constraints.Integer](v From) (To, bool) {
+	to := To(v)
+
+	return to, From(to) == v && (to < 0) == (v < 0)
+}
@@ -0,0 +1,51 @@
+package enum
+
+import "testing"
+
+func TestWire(t *testing.T) {
+	// As generated by flatc.
+	type fbColor int8
+
+	type color uint16
+
+	red := NewWithID[color]("Red", 1)
+	blue := NewWithID[color]("Blue", 2)
+	huge := NewWithID[color]("Huge", 1000)
+	unknown := NewWithID[color]("Unknown", 0)
+
+	w, err := ToWire[fbColor](blue)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if w != 2 {
+		t.Errorf("expected 2, got %d", w)
+	}
+
+	if _, err := ToWire[fbColor](huge); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if _, err := ToWire[fbColor](Enum[color]{}); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	e, err := FromWire[color](fbColor(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if e != red {
+		t.Errorf("expected %s, got %s", red, e)
+	}
+
+	for _, w := range []fbColor{-1, 3} {
+		if _, err := FromWire[color](w); err == nil {
+			t.Errorf("expected error for %d, got nil", w)
+		}
+
+		if e := FromWireOr(w, unknown); e != unknown {
+			t.Errorf("expected %s for %d, got %s", unknown, w, e)
+		}
+	}
+}
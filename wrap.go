@@ -0,0 +1,45 @@
+package enum
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Stringer is the constraint for the types accepted by Wrap: integer types
+// with a String method, as usually generated by the stringer tool for const
+// iota blocks.
+type Stringer interface {
+	constraints.Integer
+	fmt.Stringer
+}
+
+// Wrap registers existing values of type T as enums, using their String
+// output as names and the values themselves as IDs, and returns the
+// corresponding Enum values in the same order. This allows types declared as
+// constants to be used as Enum[T] (for parsing, marshaling, listing, etc.)
+// without rewriting them:
+//
+//	type Color int
+//
+//	const (
+//		Red Color = iota
+//		Green
+//	)
+//
+//	var _ = enum.Wrap(Red, Green)
+//
+// The original type T itself is not changed. Like New, this panics if a name
+// or ID is already registered for type T.
+func Wrap[T Stringer](values ...T) []Enum[T] {
+	s := getOrCreateSetForType[T]()
+
+	enums := make([]Enum[T], 0, len(values))
+	for _, v := range values {
+		e := s.AddWithID(v.String(), v, attributes{registeredAt: callSite(1)})
+
+		enums = append(enums, Enum[T]{internalEnumWrapper[T]{e}})
+	}
+
+	return enums
+}
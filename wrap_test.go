@@ -0,0 +1,78 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+type wrapColor int
+
+const (
+	wrapRed wrapColor = iota
+	wrapGreen
+	wrapBlue wrapColor = 10
+)
+
+func (c wrapColor) String() string {
+	switch c {
+	case wrapRed:
+		return "Red"
+	case wrapGreen:
+		return "Green"
+	case wrapBlue:
+		return "Blue"
+	}
+
+	return "Unknown"
+}
+
+var wrappedColors = Wrap(wrapRed, wrapGreen, wrapBlue)
+
+type wrapShade int
+
+func (s wrapShade) String() string {
+	return fmt.Sprintf("Shade%d", int(s))
+}
+
+var (
+	_          = Wrap[wrapShade](0, 2)
+	wrapShade1 = New[wrapShade]("Light")
+	wrapShade3 = New[wrapShade]("Dark")
+)
+
+func TestWrap(t *testing.T) {
+	for i, c := range []wrapColor{wrapRed, wrapGreen, wrapBlue} {
+		e := wrappedColors[i]
+		if e.ID() != c || e.Name() != c.String() {
+			t.Errorf("expected %s with ID %d, got %s with ID %d", c, c, e.Name(), e.ID())
+		}
+	}
+
+	var e Enum[wrapColor]
+	if err := json.Unmarshal([]byte(`"Blue"`), &e); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if e.ID() != wrapBlue {
+		t.Errorf("expected ID %d, got %d", wrapBlue, e.ID())
+	}
+
+	if n := len(EnumsByType[wrapColor]()); n != 3 {
+		t.Errorf("expected 3 enums, got %d", n)
+	}
+}
+
+func TestWrap_AutoIDsSkipWrapped(t *testing.T) {
+	if wrapShade1.ID() != 1 {
+		t.Errorf("expected ID 1, got %d", wrapShade1.ID())
+	}
+
+	if wrapShade3.ID() != 3 {
+		t.Errorf("expected ID 3, got %d", wrapShade3.ID())
+	}
+
+	expectPanic(t, func() {
+		Wrap[wrapShade](3)
+	})
+}
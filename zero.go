@@ -0,0 +1,44 @@
+package enum
+
+import "golang.org/x/exp/constraints"
+
+// SetZeroValue makes IsZero return true for the given value, in addition to
+// Enums that are not initialized. It is intended for types with a designated
+// default (usually the enum with ID 0, see RequireUnknownZero) that should
+// not be emitted for optional fields:
+//
+//	func init() {
+//		enum.SetZeroValue(UnknownRole)
+//	}
+//
+// Calling it again replaces the previous value.
+func SetZeroValue[E EnumType[T], T constraints.Integer](value E) {
+	e := Enum[T](value)
+	if !e.Valid() {
+		panic(notInitialized[T]())
+	}
+
+	getOrCreateSetForType[T]().SetZero(e.internalEnum)
+}
+
+// IsZero returns true if this Enum is not initialized (the zero value of
+// Enum[T]) or is the value set with SetZeroValue. It is used by the omitzero
+// option of encoding/json (and by other encoders with similar options), so
+// Enum fields that are not set are omitted:
+//
+//	type User struct {
+//		Role RoleEnum `json:"role,omitzero"`
+//	}
+func (e internalEnumWrapper[T]) IsZero() bool {
+	if e.internalEnum == nil {
+		return true
+	}
+
+	if e.set == nil {
+		return false
+	}
+
+	zero := e.set.Zero()
+
+	return zero != nil && (zero == e.internalEnum || (zero.id == e.id && zero.name == e.name))
+}
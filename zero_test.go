@@ -0,0 +1,62 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIsZero(t *testing.T) {
+	type zeroStatus int
+
+	none := New[zeroStatus]("None")
+	active := New[zeroStatus]("Active")
+
+	if !(Enum[zeroStatus]{}).IsZero() || none.IsZero() || active.IsZero() {
+		t.Errorf("expected only the zero value to be zero")
+	}
+
+	SetZeroValue(none)
+
+	if !none.IsZero() || active.IsZero() {
+		t.Errorf("expected %s to be zero", none)
+	}
+
+	// A deep copy, as made by some libraries.
+	deepCopy := Enum[zeroStatus]{internalEnumWrapper[zeroStatus]{&internalEnum[zeroStatus]{name: "None", id: none.ID(), set: none.set}}}
+	if !deepCopy.IsZero() {
+		t.Errorf("expected copy of %s to be zero", none)
+	}
+
+	expectPanic(t, func() {
+		SetZeroValue(Enum[zeroStatus]{})
+	})
+}
+
+func TestIsZero_OmitZero(t *testing.T) {
+	if data, _ := json.Marshal(struct {
+		A struct{ B int } `json:",omitzero"`
+	}{}); string(data) != "{}" {
+		t.Skip("encoding/json does not support omitzero")
+	}
+
+	type user struct {
+		Role RoleEnum `json:"role,omitzero"`
+	}
+
+	for _, test := range []struct {
+		user     user
+		expected string
+	}{
+		{user{}, `{}`},
+		{user{Admin}, `{"role":"Admin"}`},
+	} {
+		data, err := json.Marshal(test.user)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if string(data) != test.expected {
+			t.Errorf("expected %s, got %s", test.expected, data)
+		}
+	}
+}